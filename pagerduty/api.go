@@ -0,0 +1,106 @@
+package pagerduty
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// apiRequest performs a request against a PagerDuty REST API endpoint which
+// is not (yet) covered by the go-pagerduty client. It reuses the
+// configuration of the given client and decodes API errors into
+// *pagerduty.Error so that helpers such as isErrCode keep working.
+func apiRequest(client *pagerduty.Client, method, path string, query url.Values, body, v interface{}) (*pagerduty.Response, error) {
+	return apiRequestWithHeaders(client, method, path, query, nil, body, v)
+}
+
+// apiRequestWithHeaders is like apiRequest but adds the given headers to the
+// request, e.g. the From header required by the incident endpoints.
+func apiRequestWithHeaders(client *pagerduty.Client, method, path string, query url.Values, headers http.Header, body, v interface{}) (*pagerduty.Response, error) {
+	var buf io.ReadWriter
+	if body != nil {
+		buf = new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return nil, err
+		}
+	}
+
+	u := client.Config.BaseURL + path
+	if v := query.Encode(); v != "" {
+		u = fmt.Sprintf("%s?%s", u, v)
+	}
+
+	if client.Config.Debug {
+		log.Printf("[DEBUG] PagerDuty - Preparing %s request to %s with body: %s", method, u, buf)
+	}
+
+	req, err := http.NewRequest(method, u, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Accept", "application/vnd.pagerduty+json;version=2")
+	req.Header.Add("Authorization", fmt.Sprintf("Token token=%s", client.Config.Token))
+	req.Header.Add("Content-Type", "application/json")
+	if client.Config.UserAgent != "" {
+		req.Header.Add("User-Agent", client.Config.UserAgent)
+	}
+	for k, values := range headers {
+		for _, value := range values {
+			req.Header.Add(k, value)
+		}
+	}
+
+	resp, err := client.Config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pagerduty.Response{
+		Response:  resp,
+		BodyBytes: bodyBytes,
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return response, decodeAPIError(response)
+	}
+
+	if v != nil && len(bodyBytes) > 0 {
+		if err := json.Unmarshal(bodyBytes, v); err != nil {
+			return response, err
+		}
+	}
+
+	return response, nil
+}
+
+func decodeAPIError(res *pagerduty.Response) error {
+	v := &struct {
+		Error *pagerduty.Error `json:"error"`
+	}{Error: &pagerduty.Error{ErrorResponse: res}}
+
+	if err := json.Unmarshal(res.BodyBytes, v); err != nil || v.Error == nil {
+		// The body is kept as is, as it's the only detail there is
+		if body := strings.TrimSpace(string(res.BodyBytes)); body != "" {
+			return fmt.Errorf("%s API call to %s failed: %v: %s", res.Response.Request.Method, res.Response.Request.URL.String(), res.Response.Status, body)
+		}
+		return fmt.Errorf("%s API call to %s failed: %v", res.Response.Request.Method, res.Response.Request.URL.String(), res.Response.Status)
+	}
+
+	v.Error.ErrorResponse = res
+	return v.Error
+}
@@ -0,0 +1,159 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// featureEntitlements names the features of PagerDuty plans and add-ons that
+// the resources and data sources of the provider depend on.
+var featureEntitlements = map[string]string{
+	"pagerduty_audit_records":                   "Audit Records",
+	"pagerduty_business_service":                "Business Services",
+	"pagerduty_business_service_subscriber":     "Business Services",
+	"pagerduty_custom_event_transformer":        "Custom Event Transformers",
+	"pagerduty_event_orchestration":             "Event Orchestration",
+	"pagerduty_event_orchestration_router":      "Event Orchestration",
+	"pagerduty_event_orchestration_service":     "Event Orchestration (variables, extractions and conditions on event fields of service orchestrations need the AIOps add-on)",
+	"pagerduty_event_orchestration_unrouted":    "Event Orchestration",
+	"pagerduty_event_rule":                      "Event Rules",
+	"pagerduty_incident_analytics":              "Analytics",
+	"pagerduty_jira_cloud_account_mapping":      "Jira Cloud",
+	"pagerduty_jira_cloud_account_mapping_rule": "Jira Cloud",
+	"pagerduty_responder_analytics":             "Analytics",
+	"pagerduty_response_play":                   "Response Plays",
+	"pagerduty_ruleset":                         "Event Rules",
+	"pagerduty_ruleset_rule":                    "Event Rules",
+	"pagerduty_service_dependency":              "Business Services",
+	"pagerduty_service_event_rule":              "Event Rules",
+	"pagerduty_standard":                        "Service Standards",
+	"pagerduty_standards":                       "Service Standards",
+	"pagerduty_standards_resource_scores":       "Service Standards",
+	"pagerduty_standards_resources_scores":      "Service Standards",
+	"pagerduty_team":                            "Teams",
+	"pagerduty_team_membership":                 "Teams",
+	"pagerduty_team_memberships":                "Teams",
+	"pagerduty_teams":                           "Teams",
+	"pagerduty_team_members":                    "Teams",
+}
+
+// entitlementErrorTerms are found in the errors the API returns when the plan
+// of the account doesn't include a feature, as opposed to the token lacking
+// access to an object.
+var entitlementErrorTerms = []string{
+	"plan",
+	"upgrade",
+	"feature",
+	"entitle",
+	"add-on",
+	"not available",
+}
+
+// describeAPIErrors makes the operations of the resource report the errors
+// returned by the API in full: the request, the status, the PagerDuty error
+// code and message, and each of the detailed errors, along with the
+// attribute it's about when that can be told from the error. Errors due to
+// the plan of the account not including a feature the resource depends on
+// name the feature, so they aren't mistaken for a problem with the token.
+func describeAPIErrors(name string, r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			err := f(d, meta)
+			if err == nil {
+				return nil
+			}
+			return describeAPIError(err, name, r.Schema)
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+}
+
+// describeAPIError replaces the description of the PagerDuty error found in
+// err, if any, with a detailed one, keeping the context it was wrapped in.
+func describeAPIError(err error, name string, s map[string]*schema.Schema) error {
+	var apiErr *pagerduty.Error
+	if !errors.As(err, &apiErr) || apiErr.ErrorResponse == nil || apiErr.ErrorResponse.Response == nil {
+		return err
+	}
+
+	msg := strings.Replace(err.Error(), apiErr.Error(), formatAPIError(apiErr, s), 1)
+	if isEntitlementError(apiErr) {
+		feature := featureEntitlements[name]
+		if feature == "" {
+			feature = "a feature"
+		}
+		msg = fmt.Sprintf("%s needs %s, which the plan of the PagerDuty account doesn't include. "+
+			"This isn't a problem with the API token: an account owner needs to upgrade the plan or add the feature, "+
+			"or the %s needs to be removed from the configuration.\n\n%s", name, feature, name, msg)
+	}
+	return errors.New(msg)
+}
+
+// isEntitlementError reports whether the API rejected the request because
+// the plan of the account doesn't include a feature. That's always the case
+// for 402 responses, and for 403 responses whose errors say so.
+func isEntitlementError(e *pagerduty.Error) bool {
+	switch e.ErrorResponse.Response.StatusCode {
+	case 402:
+		return true
+	case 403:
+		text := strings.ToLower(fmt.Sprintf("%s %v", e.Message, e.Errors))
+		for _, term := range entitlementErrorTerms {
+			if strings.Contains(text, term) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func formatAPIError(e *pagerduty.Error, s map[string]*schema.Schema) string {
+	resp := e.ErrorResponse.Response
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s returned %s", resp.Request.Method, resp.Request.URL.Path, resp.Status)
+	if e.Code != 0 || e.Message != "" {
+		fmt.Fprintf(&b, ": PagerDuty error %d: %s", e.Code, e.Message)
+	}
+
+	details, _ := e.Errors.([]interface{})
+	for _, v := range details {
+		detail, ok := v.(string)
+		if !ok {
+			raw, _ := json.Marshal(v)
+			detail = string(raw)
+		}
+
+		fmt.Fprintf(&b, "\n  - %s", detail)
+		if attr := apiErrorAttribute(detail, s); attr != "" {
+			fmt.Fprintf(&b, " (attribute %q)", attr)
+		}
+	}
+
+	return b.String()
+}
+
+// apiErrorAttribute returns the attribute an error is about, when the error
+// starts with its name, e.g. escalation_policy for "Escalation policy must
+// be specified".
+func apiErrorAttribute(detail string, s map[string]*schema.Schema) string {
+	words := strings.Fields(strings.ToLower(detail))
+	for n := len(words); n > 0; n-- {
+		if attr := strings.Join(words[:n], "_"); s[attr] != nil {
+			return attr
+		}
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestDescribeAPIError(t *testing.T) {
+	u, _ := url.Parse("https://api.pagerduty.com/services?include=foo")
+	apiErr := &pagerduty.Error{
+		ErrorResponse: &pagerduty.Response{
+			Response: &http.Response{
+				Status:     "400 Bad Request",
+				StatusCode: 400,
+				Request:    &http.Request{Method: "POST", URL: u},
+			},
+		},
+		Code:    2001,
+		Message: "Invalid Input Provided",
+		Errors: []interface{}{
+			"Escalation policy must be specified.",
+			"Name has already been taken",
+			map[string]interface{}{"field": "foo"},
+		},
+	}
+	s := map[string]*schema.Schema{
+		"name":              {Type: schema.TypeString},
+		"escalation_policy": {Type: schema.TypeString},
+	}
+
+	err := describeAPIError(fmt.Errorf("Error reading: PFOO: %w", apiErr), "pagerduty_service", s)
+
+	expected := `Error reading: PFOO: POST /services returned 400 Bad Request: PagerDuty error 2001: Invalid Input Provided
+  - Escalation policy must be specified. (attribute "escalation_policy")
+  - Name has already been taken (attribute "name")
+  - {"field":"foo"}`
+	if err.Error() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, err)
+	}
+}
+
+func TestDescribeAPIError_Other(t *testing.T) {
+	err := fmt.Errorf("foo")
+	if got := describeAPIError(err, "pagerduty_service", nil); got != err {
+		t.Errorf("expected errors other than API errors to be kept, got %s", got)
+	}
+}
+
+func TestDescribeAPIError_Entitlement(t *testing.T) {
+	u, _ := url.Parse("https://api.pagerduty.com/event_orchestrations/services/PFOO")
+	newError := func(status int, message string) *pagerduty.Error {
+		return &pagerduty.Error{
+			ErrorResponse: &pagerduty.Response{
+				Response: &http.Response{
+					Status:     http.StatusText(status),
+					StatusCode: status,
+					Request:    &http.Request{Method: "PUT", URL: u},
+				},
+			},
+			Code:    2010,
+			Message: message,
+		}
+	}
+
+	cases := []struct {
+		err         *pagerduty.Error
+		entitlement bool
+	}{
+		{newError(402, "Payment Required"), true},
+		{newError(403, "Your plan does not include this feature"), true},
+		{newError(403, "Access Denied"), false},
+		{newError(400, "Invalid Input Provided"), false},
+	}
+
+	for _, c := range cases {
+		err := describeAPIError(c.err, "pagerduty_event_orchestration_service", nil)
+		prefix := "pagerduty_event_orchestration_service needs Event Orchestration"
+		if got := strings.HasPrefix(err.Error(), prefix); got != c.entitlement {
+			t.Errorf("%d %s: expected the feature to be named: %t, got: %s", c.err.ErrorResponse.Response.StatusCode, c.err.Message, c.entitlement, err)
+		}
+	}
+}
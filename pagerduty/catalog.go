@@ -0,0 +1,74 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// catalogs caches the vendors and extension schemas of the account. They
+// rarely change, so they are listed in full once per run and every vendor
+// and extension schema data source looks them up from here, instead of each
+// listing them again.
+type catalogs struct {
+	mu               sync.Mutex
+	vendors          []*pagerduty.Vendor
+	extensionSchemas []*pagerduty.ExtensionSchema
+}
+
+// listVendors returns every vendor, listing them on first use. Failed
+// listings aren't cached, so that they can be retried.
+func (c *catalogs) listVendors(client *pagerduty.Client) ([]*pagerduty.Vendor, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.vendors != nil {
+		return c.vendors, nil
+	}
+
+	vendors := []*pagerduty.Vendor{}
+	err := listPages(client, "/vendors", nil, func(raw json.RawMessage) (int, bool, error) {
+		resp := new(pagerduty.ListVendorsResponse)
+		if err := json.Unmarshal(raw, resp); err != nil {
+			return 0, false, err
+		}
+
+		vendors = append(vendors, resp.Vendors...)
+		return len(resp.Vendors), true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.vendors = vendors
+	return c.vendors, nil
+}
+
+// listExtensionSchemas returns every extension schema, listing them on first
+// use.
+func (c *catalogs) listExtensionSchemas(client *pagerduty.Client) ([]*pagerduty.ExtensionSchema, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.extensionSchemas != nil {
+		return c.extensionSchemas, nil
+	}
+
+	schemas := []*pagerduty.ExtensionSchema{}
+	err := listPages(client, "/extension_schemas", nil, func(raw json.RawMessage) (int, bool, error) {
+		resp := new(pagerduty.ListExtensionSchemasResponse)
+		if err := json.Unmarshal(raw, resp); err != nil {
+			return 0, false, err
+		}
+
+		schemas = append(schemas, resp.ExtensionSchemas...)
+		return len(resp.ExtensionSchemas), true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.extensionSchemas = schemas
+	return c.extensionSchemas, nil
+}
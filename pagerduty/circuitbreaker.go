@@ -0,0 +1,164 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// circuitBreakerThreshold is the number of consecutive failed requests
+	// after which requests are rejected without being sent.
+	circuitBreakerThreshold = 5
+
+	// circuitBreakerCooldown is how long requests are rejected before a single
+	// request is let through to check whether the API has recovered.
+	circuitBreakerCooldown = 15 * time.Second
+
+	// circuitBreakerBudget is how long requests may keep failing before the
+	// provider gives up on the API for the rest of the run.
+	circuitBreakerBudget = 5 * time.Minute
+)
+
+// circuitBreaker stops the provider from hammering the API while it's down.
+// Requests failing with a 5xx status or without a response count as
+// failures, and any other response closes the circuit again. Once the API
+// has been failing for longer than the retry budget, every request and every
+// operation of the run fails at once with an error saying the API is
+// unavailable, instead of each resource retrying on its own for minutes.
+type circuitBreaker struct {
+	next http.RoundTripper
+
+	mu           sync.Mutex
+	failures     int
+	firstFailure time.Time
+	lastErr      string
+	openUntil    time.Time
+	exhausted    bool
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+func newCircuitBreaker(next http.RoundTripper) *circuitBreaker {
+	return &circuitBreaker{
+		next: next,
+		now:  time.Now,
+	}
+}
+
+func (b *circuitBreaker) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+
+	resp, err := b.next.RoundTrip(req)
+	switch {
+	case err != nil:
+		b.record(err.Error())
+	case resp.StatusCode >= 500:
+		b.record(resp.Status)
+	default:
+		b.reset()
+	}
+
+	return resp, err
+}
+
+// allow returns an error if the request must not be sent. While the circuit
+// is open, one request is let through per cooldown.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.exhausted {
+		return b.unavailableError()
+	}
+
+	now := b.now()
+	if now.Before(b.openUntil) {
+		return b.unavailableError()
+	}
+	if b.failures >= circuitBreakerThreshold {
+		b.openUntil = now.Add(circuitBreakerCooldown)
+	}
+	return nil
+}
+
+func (b *circuitBreaker) record(reason string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if b.failures == 0 {
+		b.firstFailure = now
+	}
+	b.failures++
+	b.lastErr = reason
+
+	if b.failures == circuitBreakerThreshold {
+		log.Printf("[WARN] PagerDuty API requests are failing, pausing them for %s: %s", circuitBreakerCooldown, reason)
+		b.openUntil = now.Add(circuitBreakerCooldown)
+	}
+	if b.failures >= circuitBreakerThreshold && now.Sub(b.firstFailure) >= circuitBreakerBudget {
+		b.exhausted = true
+	}
+}
+
+func (b *circuitBreaker) reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.exhausted {
+		return
+	}
+	b.failures = 0
+	b.openUntil = time.Time{}
+}
+
+// unavailable returns an error once the retry budget is exhausted.
+func (b *circuitBreaker) unavailable() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.exhausted {
+		return nil
+	}
+	return b.unavailableError()
+}
+
+func (b *circuitBreaker) unavailableError() error {
+	if b.exhausted {
+		return fmt.Errorf("PagerDuty API unavailable: requests have been failing for over %s, giving up. Last error: %s", circuitBreakerBudget, b.lastErr)
+	}
+	return fmt.Errorf("PagerDuty API unavailable: %d consecutive requests failed, retrying in at most %s. Last error: %s", b.failures, circuitBreakerCooldown, b.lastErr)
+}
+
+// failFastWhenUnavailable makes the operations of the resource fail at once
+// when the circuit breaker of the provider has given up on the API.
+func failFastWhenUnavailable(r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			if config, ok := meta.(*Config); ok {
+				if breaker := config.circuitBreaker(); breaker != nil {
+					if err := breaker.unavailable(); err != nil {
+						return err
+					}
+				}
+			}
+			return f(d, meta)
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+}
@@ -0,0 +1,120 @@
+package pagerduty
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	status := 503
+	sent := 0
+
+	b := newCircuitBreaker(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{StatusCode: status, Status: http.StatusText(status), Header: http.Header{}}, nil
+	}))
+	b.now = func() time.Time { return now }
+
+	req, _ := http.NewRequest("GET", "https://api.pagerduty.com/users", nil)
+
+	for i := 0; i < circuitBreakerThreshold; i++ {
+		if _, err := b.RoundTrip(req); err != nil {
+			t.Fatalf("expected request %d to be sent, got %s", i, err)
+		}
+	}
+
+	// The circuit is open, so requests are rejected without being sent
+	if _, err := b.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "PagerDuty API unavailable") {
+		t.Fatalf("expected the request to be rejected, got %v", err)
+	}
+	if sent != circuitBreakerThreshold {
+		t.Fatalf("expected %d requests to be sent, got %d", circuitBreakerThreshold, sent)
+	}
+
+	// After the cooldown, a single request is let through
+	now = now.Add(circuitBreakerCooldown)
+	b.RoundTrip(req)
+	if _, err := b.RoundTrip(req); err == nil {
+		t.Fatal("expected only one request to be let through after the cooldown")
+	}
+
+	// A successful request closes the circuit
+	now = now.Add(circuitBreakerCooldown)
+	status = 200
+	if _, err := b.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := b.RoundTrip(req); err != nil {
+		t.Fatalf("expected the circuit to be closed, got %s", err)
+	}
+	if err := b.unavailable(); err != nil {
+		t.Fatalf("expected the API to be available, got %s", err)
+	}
+}
+
+func TestCircuitBreaker_Budget(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	status := 500
+
+	b := newCircuitBreaker(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: status, Status: "500 Internal Server Error", Header: http.Header{}}, nil
+	}))
+	b.now = func() time.Time { return now }
+
+	req, _ := http.NewRequest("GET", "https://api.pagerduty.com/users", nil)
+
+	for now.Before(time.Date(2020, 1, 1, 0, 10, 0, 0, time.UTC)) {
+		b.RoundTrip(req)
+		now = now.Add(time.Second)
+	}
+
+	err := b.unavailable()
+	if err == nil || !strings.Contains(err.Error(), "giving up") {
+		t.Fatalf("expected the budget to be exhausted, got %v", err)
+	}
+
+	// The API is given up on for the rest of the run
+	status = 200
+	now = now.Add(time.Hour)
+	if _, err := b.RoundTrip(req); err == nil {
+		t.Fatal("expected the request to be rejected")
+	}
+}
+
+// TestFailFastWhenUnavailable_Concurrent runs operations while the client is
+// configured, for go test -race to catch unsynchronized access to the breaker.
+func TestFailFastWhenUnavailable_Concurrent(t *testing.T) {
+	config := &Config{Token: "foo", SkipCredsValidation: true}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	failFastWhenUnavailable(r)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := config.Client(); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := r.Read(r.TestResourceData(), config); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
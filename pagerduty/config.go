@@ -35,6 +35,33 @@ type Config struct {
 	// UserAgent for API Client
 	UserAgent string
 
+	// Verify at plan time that escalation targets exist and can be escalated to
+	ValidateEscalationTargets bool
+
+	// What to do at plan time when new users would exceed the available
+	// license allocations: off, warn or error
+	LicenseOverageCheck string
+
+	// Verify at plan time that the objects referenced by ID exist
+	CheckReferences bool
+
+	// Look for cycles at plan time through the service dependencies which
+	// already exist in PagerDuty, not only the ones being planned
+	CheckDependencyCycles bool
+
+	// The number of requests made at once by data sources reading the
+	// details of each item they list
+	MaxParallelRequests int
+
+	plannedLicenses plannedLicenseAllocations
+	references      checkedReferences
+	dependencies    serviceDependencyGraph
+	catalogs        catalogs
+	tagAssignments  tagAssignmentBatches
+	teamMembers     teamMembers
+	breaker         *circuitBreaker
+	slots           chan struct{}
+
 	client      *pagerduty.Client
 	slackClient *pagerduty.Client
 }
@@ -61,9 +88,12 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 		return nil, fmt.Errorf(invalidCreds)
 	}
 
-	var httpClient *http.Client
-	httpClient = http.DefaultClient
-	httpClient.Transport = logging.NewTransport("PagerDuty", http.DefaultTransport)
+	// Requests rejected by the circuit breaker don't count against the rate
+	// limit.
+	c.breaker = newCircuitBreaker(newRateLimitTransport(logging.NewTransport("PagerDuty", http.DefaultTransport)))
+	httpClient := &http.Client{
+		Transport: c.breaker,
+	}
 
 	var apiUrl = c.ApiUrl
 	if c.ApiUrlOverride != "" {
@@ -98,6 +128,15 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 	return c.client, nil
 }
 
+// circuitBreaker returns the circuit breaker of the client, or nil before the
+// client is configured.
+func (c *Config) circuitBreaker() *circuitBreaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.breaker
+}
+
 func (c *Config) SlackClient() (*pagerduty.Client, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -112,9 +151,9 @@ func (c *Config) SlackClient() (*pagerduty.Client, error) {
 		return nil, fmt.Errorf(invalidCreds)
 	}
 
-	var httpClient *http.Client
-	httpClient = http.DefaultClient
-	httpClient.Transport = logging.NewTransport("PagerDuty", http.DefaultTransport)
+	httpClient := &http.Client{
+		Transport: newRateLimitTransport(logging.NewTransport("PagerDuty", http.DefaultTransport)),
+	}
 
 	config := &pagerduty.Config{
 		BaseURL:    c.AppUrl,
@@ -0,0 +1,56 @@
+package pagerduty
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePagerDutyAbilities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyAbilitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"abilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyAbilitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty abilities")
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.Abilities.List()
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		// Sorted so that the order of the API doesn't produce spurious diffs.
+		abilities := append([]string{}, resp.Abilities...)
+		sort.Strings(abilities)
+
+		d.SetId("abilities")
+		if err := d.Set("abilities", abilities); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
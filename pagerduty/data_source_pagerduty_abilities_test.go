@@ -0,0 +1,27 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyAbilities_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyAbilitiesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_abilities.all", "id", "abilities"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_abilities.all", "abilities.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyAbilitiesConfig = `
+data "pagerduty_abilities" "all" {}
+`
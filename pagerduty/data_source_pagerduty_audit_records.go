@@ -0,0 +1,267 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+type auditRecord struct {
+	ID            string `json:"id"`
+	ExecutionTime string `json:"execution_time"`
+	Action        string `json:"action"`
+	Actors        []*struct {
+		ID      string `json:"id"`
+		Type    string `json:"type"`
+		Summary string `json:"summary"`
+	} `json:"actors"`
+	Method *struct {
+		Type           string `json:"type"`
+		TruncatedToken string `json:"truncated_token"`
+		Description    string `json:"description"`
+	} `json:"method"`
+	RootResource *struct {
+		ID      string `json:"id"`
+		Type    string `json:"type"`
+		Summary string `json:"summary"`
+	} `json:"root_resource"`
+}
+
+type listAuditRecordsResponse struct {
+	Records    []*auditRecord `json:"records"`
+	NextCursor *string        `json:"next_cursor"`
+}
+
+func dataSourcePagerDutyAuditRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyAuditRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"until": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"actor_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validateValueFunc([]string{
+					"user_reference",
+					"api_key_reference",
+					"app_reference",
+				}),
+			},
+			"actor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"method_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validateValueFunc([]string{
+					"browser",
+					"oauth",
+					"api_token",
+					"identity_provider",
+					"other",
+				}),
+			},
+			"method_truncated_token": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"root_resource_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validateValueFunc([]string{
+						"users",
+						"teams",
+						"schedules",
+						"escalation_policies",
+						"services",
+					}),
+				},
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"execution_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actors": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"summary": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"method_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"method_truncated_token": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"method_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"root_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"root_resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"root_resource_summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildAuditRecordsQuery(d *schema.ResourceData) url.Values {
+	query := url.Values{}
+	for _, k := range []string{"since", "until", "actor_type", "actor_id", "method_type", "method_truncated_token"} {
+		if v := d.Get(k).(string); v != "" {
+			query.Set(k, v)
+		}
+	}
+	for _, t := range expandStringList(d.Get("root_resource_types").([]interface{})) {
+		query.Add("root_resource_types[]", t)
+	}
+
+	return query
+}
+
+// listAuditRecords follows the cursors of the audit records endpoint, which
+// doesn't support offset pagination, through listPages.
+func listAuditRecords(client *pagerduty.Client, query url.Values) ([]*auditRecord, error) {
+	var records []*auditRecord
+
+	err := listPages(client, "/audit/records", query, func(raw json.RawMessage) (int, bool, error) {
+		resp := new(listAuditRecordsResponse)
+		if err := json.Unmarshal(raw, resp); err != nil {
+			return 0, false, err
+		}
+
+		records = append(records, resp.Records...)
+		return len(resp.Records), true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func flattenAuditRecords(records []*auditRecord) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, r := range records {
+		var actors []map[string]interface{}
+		for _, a := range r.Actors {
+			actors = append(actors, map[string]interface{}{
+				"id":      a.ID,
+				"type":    a.Type,
+				"summary": a.Summary,
+			})
+		}
+
+		record := map[string]interface{}{
+			"id":             r.ID,
+			"execution_time": r.ExecutionTime,
+			"action":         r.Action,
+			"actors":         actors,
+		}
+		if r.Method != nil {
+			record["method_type"] = r.Method.Type
+			record["method_truncated_token"] = r.Method.TruncatedToken
+			record["method_description"] = r.Method.Description
+		}
+		if r.RootResource != nil {
+			record["root_resource_id"] = r.RootResource.ID
+			record["root_resource_type"] = r.RootResource.Type
+			record["root_resource_summary"] = r.RootResource.Summary
+		}
+
+		result = append(result, record)
+	}
+
+	return result
+}
+
+func dataSourcePagerDutyAuditRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty audit records")
+
+	query := buildAuditRecordsQuery(d)
+	id := strconv.Itoa(schema.HashString(query.Encode()))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		records, err := listAuditRecords(client, buildAuditRecordsQuery(d))
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(id)
+		if err := d.Set("records", flattenAuditRecords(records)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
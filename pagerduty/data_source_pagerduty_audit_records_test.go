@@ -0,0 +1,44 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyAuditRecords_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyAuditRecordsConfig(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_audit_records.users", "id"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_audit_records.users", "records.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyAuditRecordsConfig(username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+data "pagerduty_audit_records" "users" {
+  method_type         = "api_token"
+  root_resource_types = ["users"]
+
+  depends_on = [pagerduty_user.foo]
+}
+`, username, email)
+}
@@ -1,7 +1,6 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -57,7 +56,7 @@ func dataSourcePagerDutyBusinessServiceRead(d *schema.ResourceData, meta interfa
 
 		if found == nil {
 			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any business service with the name: %s", searchName),
+				errNotFound("business service", "name", searchName),
 			)
 		}
 
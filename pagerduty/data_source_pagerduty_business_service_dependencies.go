@@ -0,0 +1,263 @@
+package pagerduty
+
+import (
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyBusinessServiceDependencies() *schema.Resource {
+	serviceSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"depth": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourcePagerDutyBusinessServiceDependenciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"business_service": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"supporting_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     serviceSchema,
+			},
+			"dependent_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     serviceSchema,
+			},
+			"relationships": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supporting_service_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supporting_service_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dependent_service_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dependent_service_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyBusinessServiceDependenciesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty business service dependencies")
+
+	root := serviceDependencyNode{Type: "business_service", ID: d.Get("business_service").(string)}
+	depth := d.Get("depth").(int)
+
+	list := func(node serviceDependencyNode) ([]*pagerduty.ServiceDependency, error) {
+		resp, _, err := client.ServiceDependencies.GetServiceDependenciesForType(node.ID, node.Type)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Relationships, nil
+	}
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		graph, err := walkServiceDependencies(root, depth, list)
+		if err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(errNotFound("business service", "ID", root.ID))
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(root.ID + ":" + strconv.Itoa(depth))
+		if err := d.Set("supporting_services", flattenServiceDependencyDepths(graph.supporting)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if err := d.Set("dependent_services", flattenServiceDependencyDepths(graph.dependent)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if err := d.Set("relationships", flattenServiceDependencyRelationships(graph.relationships)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// serviceDependencyWalk is the part of the dependency graph reachable from a
+// service, with the number of dependencies separating each service from it.
+type serviceDependencyWalk struct {
+	supporting    map[serviceDependencyNode]int
+	dependent     map[serviceDependencyNode]int
+	relationships map[string]*pagerduty.ServiceDependency
+}
+
+// walkServiceDependencies follows the dependencies of root breadth first, in
+// both directions, up to maxDepth dependencies away from it, or all of them
+// when maxDepth is 0. The services supporting root are only followed to the
+// services they depend on, and the ones depending on root to the services
+// depending on them, so that the services related to root through a common
+// service aren't included.
+func walkServiceDependencies(root serviceDependencyNode, maxDepth int, list func(serviceDependencyNode) ([]*pagerduty.ServiceDependency, error)) (*serviceDependencyWalk, error) {
+	walk := &serviceDependencyWalk{
+		supporting:    make(map[serviceDependencyNode]int),
+		dependent:     make(map[serviceDependencyNode]int),
+		relationships: make(map[string]*pagerduty.ServiceDependency),
+	}
+
+	// The relationships of a service are listed once, whichever way it's reached
+	cache := make(map[serviceDependencyNode][]*pagerduty.ServiceDependency)
+	relationships := func(node serviceDependencyNode) ([]*pagerduty.ServiceDependency, error) {
+		if rels, ok := cache[node]; ok {
+			return rels, nil
+		}
+		rels, err := list(node)
+		if err != nil {
+			return nil, err
+		}
+		cache[node] = rels
+		return rels, nil
+	}
+
+	for _, supporting := range []bool{true, false} {
+		found := walk.dependent
+		if supporting {
+			found = walk.supporting
+		}
+
+		frontier := []serviceDependencyNode{root}
+		for depth := 1; len(frontier) > 0 && (maxDepth == 0 || depth <= maxDepth); depth++ {
+			var next []serviceDependencyNode
+			for _, node := range frontier {
+				rels, err := relationships(node)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, rel := range rels {
+					if rel.DependentService == nil || rel.SupportingService == nil {
+						continue
+					}
+					from, to := rel.DependentService, rel.SupportingService
+					if !supporting {
+						from, to = to, from
+					}
+					if from.ID != node.ID {
+						continue
+					}
+
+					walk.relationships[rel.ID] = rel
+
+					other := newServiceDependencyNode(to.Type, to.ID)
+					if _, ok := found[other]; ok || other == root {
+						continue
+					}
+					found[other] = depth
+					next = append(next, other)
+				}
+			}
+			frontier = next
+		}
+	}
+
+	return walk, nil
+}
+
+// flattenServiceDependencyDepths flattens the services ordered by depth, then
+// by type and ID.
+func flattenServiceDependencyDepths(depths map[serviceDependencyNode]int) []map[string]interface{} {
+	nodes := make([]serviceDependencyNode, 0, len(depths))
+	for n := range depths {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if depths[nodes[i]] != depths[nodes[j]] {
+			return depths[nodes[i]] < depths[nodes[j]]
+		}
+		return nodes[i].String() < nodes[j].String()
+	})
+
+	result := make([]map[string]interface{}, len(nodes))
+	for i, n := range nodes {
+		result[i] = map[string]interface{}{
+			"id":    n.ID,
+			"type":  n.Type,
+			"depth": depths[n],
+		}
+	}
+
+	return result
+}
+
+func flattenServiceDependencyRelationships(relationships map[string]*pagerduty.ServiceDependency) []map[string]interface{} {
+	ids := make([]string, 0, len(relationships))
+	for id := range relationships {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	result := make([]map[string]interface{}, len(ids))
+	for i, id := range ids {
+		rel := relationships[id]
+		supporting := newServiceDependencyNode(rel.SupportingService.Type, rel.SupportingService.ID)
+		dependent := newServiceDependencyNode(rel.DependentService.Type, rel.DependentService.ID)
+		result[i] = map[string]interface{}{
+			"id":                      id,
+			"supporting_service_id":   supporting.ID,
+			"supporting_service_type": supporting.Type,
+			"dependent_service_id":    dependent.ID,
+			"dependent_service_type":  dependent.Type,
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,143 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccDataSourcePagerDutyBusinessServiceDependencies_Basic(t *testing.T) {
+	businessService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	dataSourceName := "data.pagerduty_business_service_dependencies.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyBusinessServiceDependenciesConfig(businessService, username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "supporting_services.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "supporting_services.0.id", "pagerduty_service.foo", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "supporting_services.0.type", "service"),
+					resource.TestCheckResourceAttr(dataSourceName, "supporting_services.0.depth", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "dependent_services.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "relationships.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "relationships.0.dependent_service_id", "pagerduty_business_service.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyBusinessServiceDependenciesConfig(businessService, username, email, escalationPolicy, service string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_business_service" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service_dependency" "foo" {
+  dependency {
+    dependent_service {
+      id   = pagerduty_business_service.foo.id
+      type = "business_service"
+    }
+    supporting_service {
+      id   = pagerduty_service.foo.id
+      type = "service"
+    }
+  }
+}
+
+data "pagerduty_business_service_dependencies" "foo" {
+  business_service = pagerduty_service_dependency.foo.dependency[0].dependent_service[0].id
+}
+`, businessService, username, email, escalationPolicy, service)
+}
+
+func TestWalkServiceDependencies(t *testing.T) {
+	rel := func(id, dependentType, dependent, supportingType, supporting string) *pagerduty.ServiceDependency {
+		return &pagerduty.ServiceDependency{
+			ID:                id,
+			DependentService:  &pagerduty.ServiceObj{ID: dependent, Type: dependentType},
+			SupportingService: &pagerduty.ServiceObj{ID: supporting, Type: supportingType},
+		}
+	}
+
+	// portal -> checkout -> api -> db, with search also depending on api
+	rels := []*pagerduty.ServiceDependency{
+		rel("R1", "business_service", "portal", "business_service", "checkout"),
+		rel("R2", "business_service", "checkout", "technical_service_reference", "api"),
+		rel("R3", "service", "api", "service", "db"),
+		rel("R4", "business_service", "search", "service", "api"),
+	}
+	list := func(node serviceDependencyNode) ([]*pagerduty.ServiceDependency, error) {
+		var result []*pagerduty.ServiceDependency
+		for _, r := range rels {
+			if r.DependentService.ID == node.ID || r.SupportingService.ID == node.ID {
+				result = append(result, r)
+			}
+		}
+		return result, nil
+	}
+
+	root := serviceDependencyNode{Type: "business_service", ID: "checkout"}
+
+	cases := []struct {
+		depth         int
+		supporting    string
+		dependent     string
+		relationships int
+	}{
+		{0, "[map[depth:1 id:api type:service] map[depth:2 id:db type:service]]", "[map[depth:1 id:portal type:business_service]]", 3},
+		{1, "[map[depth:1 id:api type:service]]", "[map[depth:1 id:portal type:business_service]]", 2},
+	}
+
+	for _, c := range cases {
+		walk, err := walkServiceDependencies(root, c.depth, list)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := fmt.Sprint(flattenServiceDependencyDepths(walk.supporting)); got != c.supporting {
+			t.Errorf("depth %d: expected supporting services %s, got %s", c.depth, c.supporting, got)
+		}
+		if got := fmt.Sprint(flattenServiceDependencyDepths(walk.dependent)); got != c.dependent {
+			t.Errorf("depth %d: expected dependent services %s, got %s", c.depth, c.dependent, got)
+		}
+		if got := len(walk.relationships); got != c.relationships {
+			t.Errorf("depth %d: expected %d relationships, got %d", c.depth, c.relationships, got)
+		}
+	}
+}
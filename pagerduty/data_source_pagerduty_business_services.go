@@ -0,0 +1,133 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyBusinessServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyBusinessServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"team_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"business_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"point_of_contact": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"team": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyBusinessServicesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty business services")
+
+	name := d.Get("name").(string)
+	teamIDs := expandStringList(d.Get("team_ids").([]interface{}))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.BusinessServices.List()
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		id := name + "|" + strings.Join(teamIDs, ",")
+
+		d.SetId(strconv.Itoa(schema.HashString(id)))
+		if err := d.Set("business_services", flattenDataSourceBusinessServices(resp.BusinessServices, name, teamIDs)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// flattenDataSourceBusinessServices flattens the business services whose name
+// matches name the way the pagerduty_vendors data source matches it, and
+// which belong to one of the teams when any is given.
+func flattenDataSourceBusinessServices(businessServices []*pagerduty.BusinessService, name string, teamIDs []string) []map[string]interface{} {
+	pattern := namePattern(name)
+
+	teams := make(map[string]bool, len(teamIDs))
+	for _, id := range teamIDs {
+		teams[id] = true
+	}
+
+	result := make([]map[string]interface{}, 0, len(businessServices))
+	for _, bs := range businessServices {
+		team := ""
+		if bs.Team != nil {
+			team = bs.Team.ID
+		}
+
+		if !pattern.MatchString(bs.Name) || len(teams) > 0 && !teams[team] {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":               bs.ID,
+			"name":             bs.Name,
+			"description":      bs.Description,
+			"point_of_contact": bs.PointOfContact,
+			"team":             team,
+			"type":             bs.Type,
+			"html_url":         bs.HTMLUrl,
+		})
+	}
+
+	return result
+}
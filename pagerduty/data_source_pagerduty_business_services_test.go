@@ -0,0 +1,85 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccDataSourcePagerDutyBusinessServices_Team(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	dataSourceName := "data.pagerduty_business_services.by_team"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyBusinessServicesConfig(name, team),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "business_services.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "business_services.0.id", "pagerduty_business_service.with_team", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "business_services.0.name", name+"-with-team"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "business_services.0.team", "pagerduty_team.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyBusinessServicesConfig(name, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_business_service" "with_team" {
+  name = "%[2]s-with-team"
+  team = pagerduty_team.foo.id
+}
+
+resource "pagerduty_business_service" "without_team" {
+  name = "%[2]s-without-team"
+}
+
+data "pagerduty_business_services" "by_team" {
+  name     = "%[2]s"
+  team_ids = [pagerduty_team.foo.id]
+
+  depends_on = [pagerduty_business_service.with_team, pagerduty_business_service.without_team]
+}
+`, team, name)
+}
+
+func TestFlattenDataSourceBusinessServices(t *testing.T) {
+	businessServices := []*pagerduty.BusinessService{
+		{ID: "P1", Name: "Checkout", Team: &pagerduty.BusinessServiceTeam{ID: "T1"}},
+		{ID: "P2", Name: "Checkout Mobile"},
+		{ID: "P3", Name: "Search", Team: &pagerduty.BusinessServiceTeam{ID: "T2"}},
+	}
+
+	cases := []struct {
+		name    string
+		teamIDs []string
+		ids     []string
+	}{
+		{"", nil, []string{"P1", "P2", "P3"}},
+		{"checkout", nil, []string{"P1", "P2"}},
+		{"", []string{"T1", "T2"}, []string{"P1", "P3"}},
+		{"checkout", []string{"T2"}, nil},
+	}
+
+	for _, c := range cases {
+		var ids []string
+		for _, bs := range flattenDataSourceBusinessServices(businessServices, c.name, c.teamIDs) {
+			ids = append(ids, bs["id"].(string))
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(c.ids) {
+			t.Fatalf("name %q, teams %v: expected %v, got %v", c.name, c.teamIDs, c.ids, ids)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package pagerduty
+
+import (
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyCurrentUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyCurrentUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"token_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subdomain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"abilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+type currentUserPayload struct {
+	User *pagerduty.User `json:"user"`
+}
+
+type accountUsersPayload struct {
+	Users []*pagerduty.User `json:"users"`
+}
+
+// subdomainFromHTMLURL returns the subdomain of the account from the URL of
+// one of its objects in the web application, e.g. acme for
+// https://acme.eu.pagerduty.com/users/PXPGF42.
+func subdomainFromHTMLURL(htmlURL string) string {
+	u, err := url.Parse(htmlURL)
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+	return strings.SplitN(u.Hostname(), ".", 2)[0]
+}
+
+func dataSourcePagerDutyCurrentUserRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty current user")
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		abilities, _, err := client.Abilities.List()
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		if err := d.Set("abilities", abilities.Abilities); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		// /users/me can only be resolved for user level tokens, account level
+		// tokens are rejected with a 400 as they don't belong to any user.
+		v := new(currentUserPayload)
+		if _, err := apiRequest(client, "GET", "/users/me", nil, nil, v); err != nil {
+			if isErrCode(err, 400) {
+				// The account isn't exposed by the API, so its subdomain is
+				// read from the URL of any of its users, e.g. the owner.
+				users := new(accountUsersPayload)
+				if _, err := apiRequest(client, "GET", "/users", url.Values{"limit": {"1"}}, nil, users); err != nil {
+					time.Sleep(30 * time.Second)
+					return resource.RetryableError(err)
+				}
+
+				d.SetId("account")
+				d.Set("token_type", "account")
+				if len(users.Users) > 0 {
+					d.Set("subdomain", subdomainFromHTMLURL(users.Users[0].HTMLURL))
+				}
+				return nil
+			}
+
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(v.User.ID)
+		d.Set("token_type", "user")
+		d.Set("user_id", v.User.ID)
+		d.Set("name", v.User.Name)
+		d.Set("email", v.User.Email)
+		d.Set("role", v.User.Role)
+		d.Set("html_url", v.User.HTMLURL)
+		d.Set("subdomain", subdomainFromHTMLURL(v.User.HTMLURL))
+
+		return nil
+	})
+}
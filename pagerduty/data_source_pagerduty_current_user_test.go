@@ -0,0 +1,74 @@
+package pagerduty
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyCurrentUser_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyCurrentUserConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_current_user.me", "id"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_current_user.me", "token_type"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_current_user.me", "subdomain"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_current_user.me", "abilities.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubdomainFromHTMLURL(t *testing.T) {
+	cases := map[string]string{
+		"https://acme.pagerduty.com/users/PXPGF42":    "acme",
+		"https://acme.eu.pagerduty.com/users/PXPGF42": "acme",
+		"":           "",
+		"not a url":  "",
+		"://invalid": "",
+	}
+
+	for htmlURL, expected := range cases {
+		if got := subdomainFromHTMLURL(htmlURL); got != expected {
+			t.Errorf("expected the subdomain of %q to be %q, got %q", htmlURL, expected, got)
+		}
+	}
+}
+
+func TestDataSourcePagerDutyCurrentUserRead_AccountToken(t *testing.T) {
+	config := testStubbedConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/abilities":
+			w.Write([]byte(`{"abilities":["teams"]}`))
+		case "/users/me":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"message":"Invalid Input Provided","code":2001}}`))
+		case "/users":
+			w.Write([]byte(`{"users":[{"id":"POWNER","html_url":"https://acme.pagerduty.com/users/POWNER"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	d := dataSourcePagerDutyCurrentUser().TestResourceData()
+	if err := dataSourcePagerDutyCurrentUserRead(d, config); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "account" || d.Get("token_type") != "account" || d.Get("user_id") != "" {
+		t.Errorf("expected the account token to not belong to a user, got %q with user %q", d.Id(), d.Get("user_id"))
+	}
+	if subdomain := d.Get("subdomain"); subdomain != "acme" {
+		t.Errorf("expected the subdomain to be acme, got %q", subdomain)
+	}
+}
+
+const testAccDataSourcePagerDutyCurrentUserConfig = `
+data "pagerduty_current_user" "me" {}
+`
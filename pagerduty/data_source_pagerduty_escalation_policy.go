@@ -1,8 +1,9 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
+	"net/url"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -19,6 +20,43 @@ func dataSourcePagerDutyEscalationPolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"include_on_call": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"on_call": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"escalation_level": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schedule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -57,13 +95,57 @@ func dataSourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interf
 
 		if found == nil {
 			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any escalation policy with the name: %s", searchName),
+				errNotFound("escalation policy", "name", searchName),
 			)
 		}
 
 		d.SetId(found.ID)
 		d.Set("name", found.Name)
 
+		if !d.Get("include_on_call").(bool) {
+			return nil
+		}
+
+		q := url.Values{}
+		q.Add("escalation_policy_ids[]", found.ID)
+		onCalls, err := listOnCalls(client, q)
+		if err != nil {
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		if err := d.Set("on_call", flattenEscalationPolicyOnCalls(onCalls)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		return nil
 	})
 }
+
+// flattenEscalationPolicyOnCalls flattens the on-call entries of an escalation
+// policy ordered by escalation level. Users on call through a schedule carry
+// the ID of that schedule, direct user targets leave schedule_id empty.
+func flattenEscalationPolicyOnCalls(onCalls []*onCall) []map[string]interface{} {
+	sort.SliceStable(onCalls, func(i, j int) bool {
+		return onCalls[i].EscalationLevel < onCalls[j].EscalationLevel
+	})
+
+	var result []map[string]interface{}
+	for _, oc := range onCalls {
+		entry := map[string]interface{}{
+			"escalation_level": oc.EscalationLevel,
+			"start":            oc.Start,
+			"end":              oc.End,
+		}
+		if oc.User != nil {
+			entry["user_id"] = oc.User.ID
+			entry["user_name"] = oc.User.Summary
+		}
+		if oc.Schedule != nil {
+			entry["schedule_id"] = oc.Schedule.ID
+		}
+		result = append(result, entry)
+	}
+
+	return result
+}
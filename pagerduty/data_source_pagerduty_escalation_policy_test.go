@@ -28,6 +28,28 @@ func TestAccDataSourcePagerDutyEscalationPolicy_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyEscalationPolicy_OnCall(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEscalationPolicyOnCallConfig(username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyEscalationPolicy("pagerduty_escalation_policy.test", "data.pagerduty_escalation_policy.by_name"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_policy.by_name", "on_call.#", "1"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_policy.by_name", "on_call.0.escalation_level", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_escalation_policy.by_name", "on_call.0.user_id", "pagerduty_user.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourcePagerDutyEscalationPolicy(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -79,3 +101,31 @@ data "pagerduty_escalation_policy" "by_name" {
 }
 `, username, email, escalationPolicy)
 }
+
+func testAccDataSourcePagerDutyEscalationPolicyOnCallConfig(username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name        = "%s"
+  num_loops   = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+data "pagerduty_escalation_policy" "by_name" {
+  name            = pagerduty_escalation_policy.test.name
+  include_on_call = true
+}
+`, username, email, escalationPolicy)
+}
@@ -1,7 +1,6 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -79,7 +78,7 @@ func dataSourcePagerDutyEventOrchestrationRead(d *schema.ResourceData, meta inte
 
 		if found == nil {
 			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any Event Orchestration with the name: %s", searchName),
+				errNotFound("Event Orchestration", "name", searchName),
 			)
 		}
 
@@ -1,9 +1,7 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -20,6 +18,11 @@ func dataSourcePagerDutyExtensionSchema() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"exact_match": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -29,7 +32,8 @@ func dataSourcePagerDutyExtensionSchema() *schema.Resource {
 }
 
 func dataSourcePagerDutyExtensionSchemaRead(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
@@ -37,9 +41,12 @@ func dataSourcePagerDutyExtensionSchemaRead(d *schema.ResourceData, meta interfa
 	log.Printf("[INFO] Reading PagerDuty Extension Schema")
 
 	searchName := d.Get("name").(string)
+	exactMatch := d.Get("exact_match").(bool)
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		resp, _, err := client.ExtensionSchemas.List(&pagerduty.ListExtensionSchemasOptions{Query: searchName})
+		// The extension schemas are listed once per run and shared by every
+		// lookup.
+		schemas, err := config.catalogs.listExtensionSchemas(client)
 		if err != nil {
 			// Delaying retry by 30s as recommended by PagerDuty
 			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
@@ -47,18 +54,19 @@ func dataSourcePagerDutyExtensionSchemaRead(d *schema.ResourceData, meta interfa
 			return resource.RetryableError(err)
 		}
 
-		var found *pagerduty.ExtensionSchema
+		labels := make([]string, len(schemas))
+		for i, schema := range schemas {
+			labels[i] = schema.Label
+		}
 
-		for _, schema := range resp.ExtensionSchemas {
-			if strings.EqualFold(schema.Label, searchName) {
-				found = schema
-				break
-			}
+		var found *pagerduty.ExtensionSchema
+		if i := bestNameMatch(searchName, labels, exactMatch); i != -1 {
+			found = schemas[i]
 		}
 
 		if found == nil {
 			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any extension schema with the name: %s", searchName),
+				errNotFound("extension schema", "name", searchName),
 			)
 		}
 
@@ -24,6 +24,21 @@ func TestAccDataSourcePagerDutyExtensionSchema_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyExtensionSchema_PartialMatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyExtensionSchemaPartialMatchConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_extension_schema.foo", "name", "Generic V2 Webhook"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourcePagerDutyExtensionSchema(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -55,3 +70,10 @@ data "pagerduty_extension_schema" "foo" {
   name = "slack"
 }
 `
+
+const testAccDataSourcePagerDutyExtensionSchemaPartialMatchConfig = `
+data "pagerduty_extension_schema" "foo" {
+  name        = "generic v2"
+  exact_match = false
+}
+`
@@ -0,0 +1,126 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyExtensions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyExtensionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"extension_schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extension_object": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extensions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"extension_schema": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"extension_objects": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyExtensionsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty extensions")
+
+	schemaID := d.Get("extension_schema").(string)
+	objectID := d.Get("extension_object").(string)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var extensions []*pagerduty.Extension
+
+		o := &pagerduty.ListExtensionsOptions{
+			ExtensionSchemaID: schemaID,
+			ExtensionObjectID: objectID,
+		}
+		for {
+			resp, _, err := client.Extensions.List(o)
+			if err != nil {
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			extensions = append(extensions, resp.Extensions...)
+
+			if !resp.More {
+				break
+			}
+			o.Offset = resp.Offset + resp.Limit
+		}
+
+		d.SetId(strconv.Itoa(schema.HashString(schemaID + "/" + objectID)))
+		if err := d.Set("extensions", flattenDataSourceExtensions(extensions)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func flattenDataSourceExtensions(extensions []*pagerduty.Extension) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(extensions))
+	for _, e := range extensions {
+		schemaID := ""
+		if e.ExtensionSchema != nil {
+			schemaID = e.ExtensionSchema.ID
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                e.ID,
+			"name":              e.Name,
+			"summary":           e.Summary,
+			"html_url":          e.HTMLURL,
+			"extension_schema":  schemaID,
+			"extension_objects": flattenExtensionObjects(e.ExtensionObjects),
+		})
+	}
+
+	return result
+}
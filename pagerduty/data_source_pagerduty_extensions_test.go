@@ -0,0 +1,77 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyExtensions_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	extension := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyExtensionsConfig(name, extension),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_extensions.by_service", "extensions.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_extensions.by_service", "extensions.0.id", "pagerduty_extension.foo", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_extensions.by_service", "extensions.0.name", extension),
+					resource.TestCheckResourceAttrPair("data.pagerduty_extensions.by_service", "extensions.0.extension_schema", "data.pagerduty_extension_schema.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_extensions.by_service", "extensions.0.extension_objects.0", "pagerduty_service.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyExtensionsConfig(name, extension string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s"
+  email = "%[1]s@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[1]s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+data "pagerduty_extension_schema" "foo" {
+  name = "Generic V2 Webhook"
+}
+
+resource "pagerduty_extension" "foo" {
+  name              = "%[2]s"
+  endpoint_url      = "https://example.com/receive_a_pagerduty_webhook"
+  extension_schema  = data.pagerduty_extension_schema.foo.id
+  extension_objects = [pagerduty_service.foo.id]
+}
+
+data "pagerduty_extensions" "by_service" {
+  extension_schema = data.pagerduty_extension_schema.foo.id
+  extension_object = pagerduty_service.foo.id
+
+  depends_on = [pagerduty_extension.foo]
+}
+`, name, extension)
+}
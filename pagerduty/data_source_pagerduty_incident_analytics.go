@@ -0,0 +1,224 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// analyticsFilters are the filters shared by the aggregated metrics endpoints
+// of the Analytics API.
+type analyticsFilters struct {
+	CreatedAtStart string   `json:"created_at_start"`
+	CreatedAtEnd   string   `json:"created_at_end"`
+	ServiceIDs     []string `json:"service_ids,omitempty"`
+	TeamIDs        []string `json:"team_ids,omitempty"`
+	Urgency        string   `json:"urgency,omitempty"`
+}
+
+type analyticsRequest struct {
+	Filters  *analyticsFilters `json:"filters"`
+	TimeZone string            `json:"time_zone,omitempty"`
+}
+
+type incidentMetrics struct {
+	TotalIncidentCount             *int     `json:"total_incident_count"`
+	TotalEscalationCount           *int     `json:"total_escalation_count"`
+	TotalInterruptions             *int     `json:"total_interruptions"`
+	TotalNotifications             *int     `json:"total_notifications"`
+	TotalIncidentsAcknowledged     *int     `json:"total_incidents_acknowledged"`
+	TotalIncidentsAutoResolved     *int     `json:"total_incidents_auto_resolved"`
+	TotalIncidentsManualEscalated  *int     `json:"total_incidents_manual_escalated"`
+	TotalIncidentsTimeoutEscalated *int     `json:"total_incidents_timeout_escalated"`
+	MeanSecondsToFirstAck          *float64 `json:"mean_seconds_to_first_ack"`
+	MeanSecondsToResolve           *float64 `json:"mean_seconds_to_resolve"`
+	MeanSecondsToEngage            *float64 `json:"mean_seconds_to_engage"`
+	MeanSecondsToMobilize          *float64 `json:"mean_seconds_to_mobilize"`
+}
+
+type incidentMetricsResponse struct {
+	Data []*incidentMetrics `json:"data"`
+}
+
+// analyticsFilterSchema returns the arguments shared by the analytics data
+// sources.
+func analyticsFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"created_at_start": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateRFC3339,
+		},
+		"created_at_end": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateRFC3339,
+		},
+		"service_ids": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"team_ids": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"urgency": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validateValueFunc([]string{
+				"high",
+				"low",
+			}),
+		},
+		"time_zone": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateTimeZone,
+		},
+	}
+}
+
+func buildAnalyticsRequest(d *schema.ResourceData) *analyticsRequest {
+	return &analyticsRequest{
+		Filters: &analyticsFilters{
+			CreatedAtStart: d.Get("created_at_start").(string),
+			CreatedAtEnd:   d.Get("created_at_end").(string),
+			ServiceIDs:     expandStringList(d.Get("service_ids").([]interface{})),
+			TeamIDs:        expandStringList(d.Get("team_ids").([]interface{})),
+			Urgency:        d.Get("urgency").(string),
+		},
+		TimeZone: d.Get("time_zone").(string),
+	}
+}
+
+// analyticsRequestID identifies the results of an analytics request by its
+// filters.
+func analyticsRequestID(kind string, r *analyticsRequest) string {
+	f := r.Filters
+	key := strings.Join([]string{
+		kind,
+		f.CreatedAtStart,
+		f.CreatedAtEnd,
+		strings.Join(f.ServiceIDs, ","),
+		strings.Join(f.TeamIDs, ","),
+		f.Urgency,
+		r.TimeZone,
+	}, "|")
+
+	return strconv.Itoa(schema.HashString(key))
+}
+
+// queryAnalytics posts an analytics request, retrying while the API is rate
+// limited or unavailable.
+func queryAnalytics(meta interface{}, path string, req, v interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if _, err := apiRequest(client, "POST", path, nil, req, v); err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func dataSourcePagerDutyIncidentAnalytics() *schema.Resource {
+	s := analyticsFilterSchema()
+	for _, k := range []string{
+		"total_incident_count",
+		"total_escalation_count",
+		"total_interruptions",
+		"total_notifications",
+		"total_incidents_acknowledged",
+		"total_incidents_auto_resolved",
+		"total_incidents_manual_escalated",
+		"total_incidents_timeout_escalated",
+	} {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+	}
+	for _, k := range []string{
+		"mean_seconds_to_first_ack",
+		"mean_seconds_to_resolve",
+		"mean_seconds_to_engage",
+		"mean_seconds_to_mobilize",
+	} {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeFloat,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		Read:   dataSourcePagerDutyIncidentAnalyticsRead,
+		Schema: s,
+	}
+}
+
+func dataSourcePagerDutyIncidentAnalyticsRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty incident analytics")
+
+	req := buildAnalyticsRequest(d)
+	resp := new(incidentMetricsResponse)
+	if err := queryAnalytics(meta, "/analytics/metrics/incidents/all", req, resp); err != nil {
+		return err
+	}
+
+	if len(resp.Data) > 1 {
+		return fmt.Errorf("Expected a single aggregate of incident metrics, got %d", len(resp.Data))
+	}
+	m := new(incidentMetrics)
+	if len(resp.Data) == 1 {
+		m = resp.Data[0]
+	}
+
+	d.SetId(analyticsRequestID("incidents", req))
+
+	// Metrics are null when no incident matches the filters, they're left
+	// unset then.
+	for k, v := range map[string]*int{
+		"total_incident_count":              m.TotalIncidentCount,
+		"total_escalation_count":            m.TotalEscalationCount,
+		"total_interruptions":               m.TotalInterruptions,
+		"total_notifications":               m.TotalNotifications,
+		"total_incidents_acknowledged":      m.TotalIncidentsAcknowledged,
+		"total_incidents_auto_resolved":     m.TotalIncidentsAutoResolved,
+		"total_incidents_manual_escalated":  m.TotalIncidentsManualEscalated,
+		"total_incidents_timeout_escalated": m.TotalIncidentsTimeoutEscalated,
+	} {
+		if v != nil {
+			d.Set(k, *v)
+		}
+	}
+	for k, v := range map[string]*float64{
+		"mean_seconds_to_first_ack": m.MeanSecondsToFirstAck,
+		"mean_seconds_to_resolve":   m.MeanSecondsToResolve,
+		"mean_seconds_to_engage":    m.MeanSecondsToEngage,
+		"mean_seconds_to_mobilize":  m.MeanSecondsToMobilize,
+	} {
+		if v != nil {
+			d.Set(k, *v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package pagerduty
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyIncidentAnalytics_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyIncidentAnalyticsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_incident_analytics.all", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_incident_analytics.all", "urgency", "high"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutyIncidentAnalytics_InvalidRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourcePagerDutyIncidentAnalyticsInvalidConfig,
+				ExpectError: regexp.MustCompile("is not a valid format for argument: created_at_start"),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyIncidentAnalyticsConfig = `
+data "pagerduty_incident_analytics" "all" {
+  created_at_start = "2022-01-01T00:00:00Z"
+  created_at_end   = "2022-02-01T00:00:00Z"
+  urgency          = "high"
+  time_zone        = "Etc/UTC"
+}
+`
+
+const testAccDataSourcePagerDutyIncidentAnalyticsInvalidConfig = `
+data "pagerduty_incident_analytics" "all" {
+  created_at_start = "2022-01-01"
+  created_at_end   = "2022-02-01T00:00:00Z"
+}
+`
@@ -0,0 +1,230 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+type incidentSummary struct {
+	ID             string                      `json:"id"`
+	IncidentNumber int                         `json:"incident_number"`
+	Title          string                      `json:"title"`
+	Status         string                      `json:"status"`
+	Urgency        string                      `json:"urgency"`
+	CreatedAt      string                      `json:"created_at"`
+	HTMLURL        string                      `json:"html_url"`
+	Service        *pagerduty.ServiceReference `json:"service"`
+	Priority       *struct {
+		ID      string `json:"id"`
+		Summary string `json:"summary"`
+	} `json:"priority"`
+}
+
+type listIncidentsResponse struct {
+	Incidents []*incidentSummary `json:"incidents"`
+	Offset    int                `json:"offset"`
+	More      bool               `json:"more"`
+}
+
+func dataSourcePagerDutyIncidents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyIncidentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"team_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"statuses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validateValueFunc([]string{
+						"triggered",
+						"acknowledged",
+						"resolved",
+					}),
+				},
+			},
+			"urgencies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validateValueFunc([]string{
+						"high",
+						"low",
+					}),
+				},
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"until": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"incidents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"incident_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"urgency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildIncidentsQuery(d *schema.ResourceData) url.Values {
+	query := url.Values{}
+	for _, k := range []string{"service_ids", "team_ids", "statuses", "urgencies"} {
+		for _, v := range expandStringList(d.Get(k).([]interface{})) {
+			query.Add(k+"[]", v)
+		}
+	}
+
+	since, until := d.Get("since").(string), d.Get("until").(string)
+	if since == "" && until == "" {
+		// Without a date range, only the incidents of the last 30 days would
+		// be listed.
+		query.Set("date_range", "all")
+	}
+	if since != "" {
+		query.Set("since", since)
+	}
+	if until != "" {
+		query.Set("until", until)
+	}
+
+	return query
+}
+
+func listIncidents(client *pagerduty.Client, query url.Values) ([]*incidentSummary, error) {
+	var incidents []*incidentSummary
+
+	err := listPages(client, "/incidents", query, func(raw json.RawMessage) (int, bool, error) {
+		resp := new(listIncidentsResponse)
+		if err := json.Unmarshal(raw, resp); err != nil {
+			return 0, false, err
+		}
+
+		incidents = append(incidents, resp.Incidents...)
+		return len(resp.Incidents), true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return incidents, nil
+}
+
+func dataSourcePagerDutyIncidentsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty incidents")
+
+	id := strconv.Itoa(schema.HashString(buildIncidentsQuery(d).Encode()))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, err := listIncidents(client, buildIncidentsQuery(d))
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var incidents []map[string]interface{}
+		for _, i := range resp {
+			incident := map[string]interface{}{
+				"id":              i.ID,
+				"incident_number": i.IncidentNumber,
+				"title":           i.Title,
+				"status":          i.Status,
+				"urgency":         i.Urgency,
+				"created_at":      i.CreatedAt,
+				"html_url":        i.HTMLURL,
+			}
+			if i.Service != nil {
+				incident["service"] = i.Service.ID
+			}
+			if i.Priority != nil {
+				incident["priority"] = i.Priority.ID
+				incident["priority_name"] = i.Priority.Summary
+			}
+			incidents = append(incidents, incident)
+		}
+
+		d.SetId(id)
+		if err := d.Set("incidents", incidents); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
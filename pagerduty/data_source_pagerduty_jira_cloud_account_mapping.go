@@ -0,0 +1,81 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// jiraCloudAccountsMapping represents the connection between a PagerDuty
+// account and a Jira Cloud account. Account mappings are created through the
+// OAuth flow of the PagerDuty web UI and can't be managed through the API.
+type jiraCloudAccountsMapping struct {
+	ID               string `json:"id,omitempty"`
+	PagerDutyAccount struct {
+		Subdomain string `json:"subdomain,omitempty"`
+	} `json:"pagerduty_account"`
+	JiraCloudAccount struct {
+		BaseURL string `json:"base_url,omitempty"`
+	} `json:"jira_cloud_account"`
+}
+
+type listJiraCloudAccountsMappingsResponse struct {
+	AccountsMappings []*jiraCloudAccountsMapping `json:"accounts_mappings"`
+}
+
+func dataSourcePagerDutyJiraCloudAccountMapping() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyJiraCloudAccountMappingRead,
+
+		Schema: map[string]*schema.Schema{
+			"subdomain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"base_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyJiraCloudAccountMappingRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty Jira Cloud account mapping")
+
+	subdomain := d.Get("subdomain").(string)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp := new(listJiraCloudAccountsMappingsResponse)
+		if _, err := apiRequest(client, "GET", "/integration-jira-cloud/accounts_mappings", nil, nil, resp); err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		for _, m := range resp.AccountsMappings {
+			if m.PagerDutyAccount.Subdomain == subdomain {
+				d.SetId(m.ID)
+				d.Set("base_url", m.JiraCloudAccount.BaseURL)
+				return nil
+			}
+		}
+
+		return resource.NonRetryableError(
+			fmt.Errorf("Unable to locate any Jira Cloud account mapping for the subdomain: %s", subdomain),
+		)
+	})
+}
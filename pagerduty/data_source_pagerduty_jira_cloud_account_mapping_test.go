@@ -0,0 +1,44 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyJiraCloudAccountMapping_Basic(t *testing.T) {
+	subdomain := os.Getenv("PAGERDUTY_ACC_JIRA_CLOUD_SUBDOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckJiraCloud(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyJiraCloudAccountMappingConfig(subdomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_jira_cloud_account_mapping.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_jira_cloud_account_mapping.foo", "base_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckJiraCloud(t *testing.T) {
+	if v := os.Getenv("PAGERDUTY_ACC_JIRA_CLOUD_SUBDOMAIN"); v == "" {
+		t.Skip("PAGERDUTY_ACC_JIRA_CLOUD_SUBDOMAIN not set. Skipping Jira Cloud tests")
+	}
+}
+
+func testAccDataSourcePagerDutyJiraCloudAccountMappingConfig(subdomain string) string {
+	return fmt.Sprintf(`
+data "pagerduty_jira_cloud_account_mapping" "foo" {
+  subdomain = "%s"
+}
+`, subdomain)
+}
@@ -0,0 +1,119 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePagerDutyLicenses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyLicensesRead,
+
+		Schema: map[string]*schema.Schema{
+			"valid_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"licenses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"valid_roles": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"current_value": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"allocations_available": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyLicensesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty licenses")
+
+	validRole := d.Get("valid_role").(string)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		licenses, err := listLicenses(client)
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		result := make([]map[string]interface{}, 0, len(licenses))
+		for _, l := range licenses {
+			if validRole != "" && !l.permitsRole(validRole) {
+				continue
+			}
+
+			// Licenses without an allocation limit don't report the number
+			// of available allocations.
+			allocationsAvailable := -1
+			if l.AllocationsAvailable != nil {
+				allocationsAvailable = *l.AllocationsAvailable
+			}
+
+			result = append(result, map[string]interface{}{
+				"id":                    l.ID,
+				"name":                  l.Name,
+				"summary":               l.Summary,
+				"description":           l.Description,
+				"role_group":            l.RoleGroup,
+				"valid_roles":           l.ValidRoles,
+				"current_value":         l.CurrentValue,
+				"allocations_available": allocationsAvailable,
+			})
+		}
+
+		d.SetId(strconv.Itoa(schema.HashString(validRole)))
+		if err := d.Set("licenses", result); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
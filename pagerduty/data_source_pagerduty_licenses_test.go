@@ -0,0 +1,31 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyLicenses_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyLicensesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_licenses.all", "licenses.#"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_licenses.responders", "licenses.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyLicensesConfig = `
+data "pagerduty_licenses" "all" {}
+
+data "pagerduty_licenses" "responders" {
+  valid_role = "user"
+}
+`
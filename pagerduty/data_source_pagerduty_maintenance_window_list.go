@@ -0,0 +1,159 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyMaintenanceWindows() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyMaintenanceWindowsRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"team_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validateValueFunc([]string{
+					"past",
+					"future",
+					"ongoing",
+					"open",
+					"all",
+				}),
+			},
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"maintenance_windows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listMaintenanceWindows(client *pagerduty.Client, query url.Values) ([]*pagerduty.MaintenanceWindow, error) {
+	var windows []*pagerduty.MaintenanceWindow
+
+	err := listPages(client, "/maintenance_windows", query, func(raw json.RawMessage) (int, bool, error) {
+		resp := new(pagerduty.ListMaintenanceWindowsResponse)
+		if err := json.Unmarshal(raw, resp); err != nil {
+			return 0, false, err
+		}
+
+		windows = append(windows, resp.MaintenanceWindows...)
+		return len(resp.MaintenanceWindows), true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return windows, nil
+}
+
+func dataSourcePagerDutyMaintenanceWindowsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty maintenance windows")
+
+	query := url.Values{}
+	for _, k := range []string{"service_ids", "team_ids"} {
+		for _, v := range expandStringList(d.Get(k).([]interface{})) {
+			query.Add(k+"[]", v)
+		}
+	}
+	for _, k := range []string{"filter", "query"} {
+		if v := d.Get(k).(string); v != "" {
+			query.Set(k, v)
+		}
+	}
+	id := strconv.Itoa(schema.HashString(query.Encode()))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, err := listMaintenanceWindows(client, query)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var windows []map[string]interface{}
+		for _, w := range resp {
+			services := make([]string, 0, len(w.Services))
+			for _, s := range w.Services {
+				services = append(services, s.ID)
+			}
+
+			windows = append(windows, map[string]interface{}{
+				"id":          w.ID,
+				"description": w.Description,
+				"start_time":  w.StartTime,
+				"end_time":    w.EndTime,
+				"services":    services,
+				"html_url":    w.HTMLURL,
+			})
+		}
+
+		d.SetId(id)
+		if err := d.Set("maintenance_windows", windows); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,50 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyMaintenanceWindows_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	start := timeNowInAccLoc().Add(24 * time.Hour).Format(time.RFC3339)
+	end := timeNowInAccLoc().Add(48 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyMaintenanceWindowsConfig(name, start, end),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_maintenance_windows.future", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_maintenance_windows.future", "maintenance_windows.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_maintenance_windows.future", "maintenance_windows.0.id",
+						"pagerduty_maintenance_window.foo", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_maintenance_windows.future", "maintenance_windows.0.description", name),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_maintenance_windows.future", "maintenance_windows.0.services.0",
+						"pagerduty_service.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyMaintenanceWindowsConfig(name, start, end string) string {
+	return fmt.Sprintf(`
+%s
+
+data "pagerduty_maintenance_windows" "future" {
+  service_ids = [pagerduty_service.foo.id]
+  filter      = "future"
+
+  depends_on = [pagerduty_maintenance_window.foo]
+}
+`, testAccCheckPagerDutyMaintenanceWindowConfig(name, start, end))
+}
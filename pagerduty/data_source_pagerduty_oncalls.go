@@ -0,0 +1,182 @@
+package pagerduty
+
+import (
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePagerDutyOnCalls() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyOnCallsRead,
+
+		Schema: map[string]*schema.Schema{
+			"schedule_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"escalation_policy_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"earliest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"until": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"oncalls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"escalation_level": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"escalation_policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"escalation_policy_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schedule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schedule_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildOnCallsQuery(d *schema.ResourceData) url.Values {
+	query := url.Values{}
+	for _, k := range []string{"schedule_ids", "escalation_policy_ids", "user_ids"} {
+		for _, v := range expandStringList(d.Get(k).([]interface{})) {
+			query.Add(k+"[]", v)
+		}
+	}
+
+	if d.Get("earliest").(bool) {
+		query.Set("earliest", "true")
+	}
+	if since := d.Get("since").(string); since != "" {
+		query.Set("since", since)
+	}
+	if until := d.Get("until").(string); until != "" {
+		query.Set("until", until)
+	}
+
+	return query
+}
+
+func dataSourcePagerDutyOnCallsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty on-calls")
+
+	id := strconv.Itoa(schema.HashString(buildOnCallsQuery(d).Encode()))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		onCalls, err := listOnCalls(client, buildOnCallsQuery(d))
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(id)
+		if err := d.Set("oncalls", flattenOnCalls(onCalls)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// flattenOnCalls flattens on-call entries ordered by escalation level, so
+// that the first entry of a single schedule or escalation policy is the
+// primary on-call. Users targeted directly by an escalation rule leave the
+// schedule empty.
+func flattenOnCalls(onCalls []*onCall) []map[string]interface{} {
+	sort.SliceStable(onCalls, func(i, j int) bool {
+		return onCalls[i].EscalationLevel < onCalls[j].EscalationLevel
+	})
+
+	var result []map[string]interface{}
+	for _, oc := range onCalls {
+		entry := map[string]interface{}{
+			"escalation_level": oc.EscalationLevel,
+			"start":            oc.Start,
+			"end":              oc.End,
+		}
+		if oc.EscalationPolicy != nil {
+			entry["escalation_policy_id"] = oc.EscalationPolicy.ID
+			entry["escalation_policy_name"] = oc.EscalationPolicy.Summary
+		}
+		if oc.Schedule != nil {
+			entry["schedule_id"] = oc.Schedule.ID
+			entry["schedule_name"] = oc.Schedule.Summary
+		}
+		if oc.User != nil {
+			entry["user_id"] = oc.User.ID
+			entry["user_name"] = oc.User.Summary
+		}
+		result = append(result, entry)
+	}
+
+	return result
+}
@@ -0,0 +1,82 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyOnCalls_Schedule(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	start := time.Now().UTC().Add(24 * time.Hour).Round(time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyOnCallsConfig(username, email, schedule, escalationPolicy,
+					start.Format(time.RFC3339), start.Add(time.Hour).Format(time.RFC3339), start.Add(2*time.Hour).Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_oncalls.primary", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_oncalls.primary", "oncalls.#", "1"),
+					resource.TestCheckResourceAttr("data.pagerduty_oncalls.primary", "oncalls.0.escalation_level", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_oncalls.primary", "oncalls.0.user_id", "pagerduty_user.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_oncalls.primary", "oncalls.0.schedule_id", "pagerduty_schedule.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_oncalls.primary", "oncalls.0.escalation_policy_id", "pagerduty_escalation_policy.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyOnCallsConfig(username, email, schedule, escalationPolicy, start, since, until string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_schedule" "foo" {
+  name      = "%s"
+  time_zone = "UTC"
+
+  layer {
+    name                         = "foo"
+    start                        = "%[5]s"
+    rotation_virtual_start       = "%[5]s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id]
+  }
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[4]s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "schedule_reference"
+      id   = pagerduty_schedule.foo.id
+    }
+  }
+}
+
+data "pagerduty_oncalls" "primary" {
+  schedule_ids = [pagerduty_schedule.foo.id]
+  earliest     = true
+  since        = "%[6]s"
+  until        = "%[7]s"
+
+  depends_on = [pagerduty_escalation_policy.foo]
+}
+`, username, email, schedule, escalationPolicy, start, since, until)
+}
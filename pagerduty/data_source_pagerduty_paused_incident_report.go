@@ -0,0 +1,97 @@
+package pagerduty
+
+import (
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type pausedIncidentReportCounts struct {
+	PausedCount    int `json:"paused_count"`
+	TriggeredCount int `json:"triggered_count"`
+	ResolvedCount  int `json:"resolved_count"`
+}
+
+func dataSourcePagerDutyPausedIncidentReport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyPausedIncidentReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"suspended_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validateValueFunc([]string{
+					"auto_pause",
+					"event_rules",
+				}),
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"until": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"paused_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"triggered_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"resolved_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyPausedIncidentReportRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty paused incident report")
+
+	query := url.Values{}
+	for _, k := range []string{"service_id", "suspended_by", "since", "until"} {
+		if v := d.Get(k).(string); v != "" {
+			query.Set(k, v)
+		}
+	}
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		counts := new(pausedIncidentReportCounts)
+		if _, err := apiRequest(client, "GET", "/paused_incident_reports/counts", query, nil, counts); err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(strconv.Itoa(schema.HashString(query.Encode())))
+		d.Set("paused_count", counts.PausedCount)
+		d.Set("triggered_count", counts.TriggeredCount)
+		d.Set("resolved_count", counts.ResolvedCount)
+
+		return nil
+	})
+}
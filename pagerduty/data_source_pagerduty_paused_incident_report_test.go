@@ -0,0 +1,30 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyPausedIncidentReport_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckPagerDutyAbility(t, "preview_intelligent_alert_grouping") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyPausedIncidentReportConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_paused_incident_report.auto_pause", "paused_count"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_paused_incident_report.auto_pause", "triggered_count"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_paused_incident_report.auto_pause", "resolved_count"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyPausedIncidentReportConfig = `
+data "pagerduty_paused_incident_report" "auto_pause" {
+  suspended_by = "auto_pause"
+}
+`
@@ -1,7 +1,6 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -59,7 +58,7 @@ func dataSourcePagerDutyPriorityRead(d *schema.ResourceData, meta interface{}) e
 
 		if found == nil {
 			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any priority with name: %s", searchTeam),
+				errNotFound("priority", "name", searchTeam),
 			)
 		}
 
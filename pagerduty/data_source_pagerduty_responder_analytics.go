@@ -0,0 +1,156 @@
+package pagerduty
+
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type responderMetrics struct {
+	ResponderID                        string   `json:"responder_id"`
+	ResponderName                      string   `json:"responder_name"`
+	TeamID                             string   `json:"team_id"`
+	TeamName                           string   `json:"team_name"`
+	TotalIncidentCount                 int      `json:"total_incident_count"`
+	TotalIncidentsAcknowledged         int      `json:"total_incidents_acknowledged"`
+	TotalInterruptions                 int      `json:"total_interruptions"`
+	TotalBusinessHourInterruptions     int      `json:"total_business_hour_interruptions"`
+	TotalOffHourInterruptions          int      `json:"total_off_hour_interruptions"`
+	TotalSleepHourInterruptions        int      `json:"total_sleep_hour_interruptions"`
+	TotalNotifications                 int      `json:"total_notifications"`
+	TotalEngagedSeconds                int      `json:"total_engaged_seconds"`
+	TotalIncidentsManualEscalatedFrom  int      `json:"total_incidents_manual_escalated_from"`
+	TotalIncidentsManualEscalatedTo    int      `json:"total_incidents_manual_escalated_to"`
+	TotalIncidentsTimeoutEscalatedFrom int      `json:"total_incidents_timeout_escalated_from"`
+	TotalIncidentsTimeoutEscalatedTo   int      `json:"total_incidents_timeout_escalated_to"`
+	MeanTimeToAcknowledgeSeconds       *float64 `json:"mean_time_to_acknowledge_seconds"`
+}
+
+type responderMetricsResponse struct {
+	Data []*responderMetrics `json:"data"`
+}
+
+type responderAnalyticsFilters struct {
+	*analyticsFilters
+	ResponderIDs []string `json:"responder_ids,omitempty"`
+}
+
+type responderAnalyticsRequest struct {
+	Filters  *responderAnalyticsFilters `json:"filters"`
+	TimeZone string                     `json:"time_zone,omitempty"`
+}
+
+var responderAnalyticsCounts = []string{
+	"total_incident_count",
+	"total_incidents_acknowledged",
+	"total_interruptions",
+	"total_business_hour_interruptions",
+	"total_off_hour_interruptions",
+	"total_sleep_hour_interruptions",
+	"total_notifications",
+	"total_engaged_seconds",
+	"total_incidents_manual_escalated_from",
+	"total_incidents_manual_escalated_to",
+	"total_incidents_timeout_escalated_from",
+	"total_incidents_timeout_escalated_to",
+}
+
+func dataSourcePagerDutyResponderAnalytics() *schema.Resource {
+	responder := map[string]*schema.Schema{
+		"responder_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"responder_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"team_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"team_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mean_time_to_acknowledge_seconds": {
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+	}
+	for _, k := range responderAnalyticsCounts {
+		responder[k] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+	}
+
+	s := analyticsFilterSchema()
+	s["responder_ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["responders"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Resource{Schema: responder},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourcePagerDutyResponderAnalyticsRead,
+		Schema: s,
+	}
+}
+
+func dataSourcePagerDutyResponderAnalyticsRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty responder analytics")
+
+	r := buildAnalyticsRequest(d)
+	req := &responderAnalyticsRequest{
+		Filters: &responderAnalyticsFilters{
+			analyticsFilters: r.Filters,
+			ResponderIDs:     expandStringList(d.Get("responder_ids").([]interface{})),
+		},
+		TimeZone: r.TimeZone,
+	}
+
+	resp := new(responderMetricsResponse)
+	if err := queryAnalytics(meta, "/analytics/metrics/responders/all", req, resp); err != nil {
+		return err
+	}
+
+	var responders []map[string]interface{}
+	for _, m := range resp.Data {
+		responder := map[string]interface{}{
+			"responder_id":                           m.ResponderID,
+			"responder_name":                         m.ResponderName,
+			"team_id":                                m.TeamID,
+			"team_name":                              m.TeamName,
+			"total_incident_count":                   m.TotalIncidentCount,
+			"total_incidents_acknowledged":           m.TotalIncidentsAcknowledged,
+			"total_interruptions":                    m.TotalInterruptions,
+			"total_business_hour_interruptions":      m.TotalBusinessHourInterruptions,
+			"total_off_hour_interruptions":           m.TotalOffHourInterruptions,
+			"total_sleep_hour_interruptions":         m.TotalSleepHourInterruptions,
+			"total_notifications":                    m.TotalNotifications,
+			"total_engaged_seconds":                  m.TotalEngagedSeconds,
+			"total_incidents_manual_escalated_from":  m.TotalIncidentsManualEscalatedFrom,
+			"total_incidents_manual_escalated_to":    m.TotalIncidentsManualEscalatedTo,
+			"total_incidents_timeout_escalated_from": m.TotalIncidentsTimeoutEscalatedFrom,
+			"total_incidents_timeout_escalated_to":   m.TotalIncidentsTimeoutEscalatedTo,
+		}
+		if m.MeanTimeToAcknowledgeSeconds != nil {
+			responder["mean_time_to_acknowledge_seconds"] = *m.MeanTimeToAcknowledgeSeconds
+		}
+		responders = append(responders, responder)
+	}
+
+	d.SetId(analyticsRequestID("responders|"+strings.Join(req.Filters.ResponderIDs, ","), r))
+	if err := d.Set("responders", responders); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyResponderAnalytics_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyResponderAnalyticsConfig(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_responder_analytics.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_responder_analytics.foo", "responders.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyResponderAnalyticsConfig(username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+data "pagerduty_responder_analytics" "foo" {
+  created_at_start = "2022-01-01T00:00:00Z"
+  created_at_end   = "2022-02-01T00:00:00Z"
+  responder_ids    = [pagerduty_user.foo.id]
+}
+`, username, email)
+}
@@ -0,0 +1,91 @@
+package pagerduty
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyResponsePlay() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyResponsePlayRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"from": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"team": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"runnability": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyResponsePlayRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty response play")
+
+	searchName := d.Get("name").(string)
+
+	o := &pagerduty.ListResponsePlayOptions{
+		From: d.Get("from").(string),
+	}
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.ResponsePlays.List(o)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		names := make([]string, len(resp.ResponsePlays))
+		for i, rp := range resp.ResponsePlays {
+			names[i] = rp.Name
+		}
+
+		i := bestNameMatch(searchName, names, true)
+		if i == -1 {
+			return resource.NonRetryableError(
+				errNotFound("response play", "name", searchName),
+			)
+		}
+		found := resp.ResponsePlays[i]
+
+		d.SetId(found.ID)
+		d.Set("name", found.Name)
+		d.Set("description", found.Description)
+		d.Set("runnability", found.Runnability)
+		if found.Team != nil {
+			d.Set("team", found.Team.ID)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,74 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourcePagerDutyResponsePlay_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyResponsePlayConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyResponsePlay("pagerduty_response_play.test", "data.pagerduty_response_play.by_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyResponsePlay(src, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		srcR := s.RootModule().Resources[src]
+		srcA := srcR.Primary.Attributes
+
+		r := s.RootModule().Resources[n]
+		a := r.Primary.Attributes
+
+		if a["id"] == "" {
+			return fmt.Errorf("Expected to get a response play ID from PagerDuty")
+		}
+
+		testAtts := []string{"id", "name", "description"}
+
+		for _, att := range testAtts {
+			if a[att] != srcA[att] {
+				return fmt.Errorf("Expected the response play %s to be: %s, but got: %s", att, srcA[att], a[att])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourcePagerDutyResponsePlayConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%[1]s"
+  email = "%[1]s@foo.test"
+}
+
+resource "pagerduty_response_play" "test" {
+  name = "%[1]s"
+  from = pagerduty_user.test.email
+  subscriber {
+    type = "user_reference"
+    id   = pagerduty_user.test.id
+  }
+}
+
+data "pagerduty_response_play" "by_name" {
+  name = pagerduty_response_play.test.name
+  from = pagerduty_user.test.email
+}
+`, name)
+}
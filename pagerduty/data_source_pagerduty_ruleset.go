@@ -1,7 +1,6 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -16,8 +15,14 @@ func dataSourcePagerDutyRuleset() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "routing_key"},
+			},
+			"routing_key": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"routing_keys": {
 				Type:     schema.TypeList,
@@ -39,6 +44,7 @@ func dataSourcePagerDutyRulesetRead(d *schema.ResourceData, meta interface{}) er
 	log.Printf("[INFO] Reading PagerDuty ruleset")
 
 	searchName := d.Get("name").(string)
+	routingKey := d.Get("routing_key").(string)
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		resp, _, err := client.Rulesets.List()
@@ -52,7 +58,8 @@ func dataSourcePagerDutyRulesetRead(d *schema.ResourceData, meta interface{}) er
 		var found *pagerduty.Ruleset
 
 		for _, ruleset := range resp.Rulesets {
-			if ruleset.Name == searchName {
+			if routingKey != "" && rulesetHasRoutingKey(ruleset, routingKey) ||
+				routingKey == "" && ruleset.Name == searchName {
 				found = ruleset
 				break
 			}
@@ -60,7 +67,7 @@ func dataSourcePagerDutyRulesetRead(d *schema.ResourceData, meta interface{}) er
 
 		if found == nil {
 			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any ruleset with the name: %s", searchName),
+				errNotFound("ruleset", "name", searchName, "routing key", routingKey),
 			)
 		}
 
@@ -71,3 +78,12 @@ func dataSourcePagerDutyRulesetRead(d *schema.ResourceData, meta interface{}) er
 		return nil
 	})
 }
+
+func rulesetHasRoutingKey(ruleset *pagerduty.Ruleset, routingKey string) bool {
+	for _, k := range ruleset.RoutingKeys {
+		if k == routingKey {
+			return true
+		}
+	}
+	return false
+}
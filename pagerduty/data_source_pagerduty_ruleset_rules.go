@@ -0,0 +1,128 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyRulesetRules() *schema.Resource {
+	// The rules have the same attributes as the pagerduty_ruleset_rule resource
+	rule := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for k, v := range resourcePagerDutyRulesetRule().Schema {
+		if k != "ruleset" {
+			rule[k] = computedSchema(v)
+		}
+	}
+
+	return &schema.Resource{
+		Read: dataSourcePagerDutyRulesetRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"ruleset": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: rule},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyRulesetRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty ruleset rules")
+
+	rulesetID := d.Get("ruleset").(string)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var rules []*pagerduty.RulesetRule
+
+		err := listPages(client, fmt.Sprintf("/rulesets/%s/rules", rulesetID), nil, func(raw json.RawMessage) (int, bool, error) {
+			resp := new(pagerduty.ListRulesetRulesResponse)
+			if err := json.Unmarshal(raw, resp); err != nil {
+				return 0, false, err
+			}
+
+			rules = append(rules, resp.Rules...)
+			return len(resp.Rules), true, nil
+		})
+		if err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(errNotFound("ruleset", "ID", rulesetID))
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(rulesetID)
+		if err := d.Set("rules", flattenDataSourceRulesetRules(rules)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// flattenDataSourceRulesetRules flattens the rules ordered by position, the
+// catch-all rule, which has none, coming last.
+func flattenDataSourceRulesetRules(rules []*pagerduty.RulesetRule) []map[string]interface{} {
+	position := func(r *pagerduty.RulesetRule) int {
+		if r.CatchAll || r.Position == nil {
+			return int(^uint(0) >> 1)
+		}
+		return *r.Position
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return position(rules[i]) < position(rules[j])
+	})
+
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, r := range rules {
+		rule := map[string]interface{}{
+			"id":        r.ID,
+			"disabled":  r.Disabled,
+			"catch_all": r.CatchAll,
+		}
+		if r.Position != nil {
+			rule["position"] = *r.Position
+		}
+		if r.Conditions != nil {
+			rule["conditions"] = flattenConditions(r.Conditions)
+		}
+		if r.Actions != nil {
+			rule["actions"] = flattenActions(r.Actions)
+		}
+		if r.TimeFrame != nil {
+			rule["time_frame"] = flattenTimeFrame(r.TimeFrame)
+		}
+		if r.Variables != nil {
+			rule["variable"] = flattenRuleVariables(r.Variables)
+		}
+
+		result = append(result, rule)
+	}
+
+	return result
+}
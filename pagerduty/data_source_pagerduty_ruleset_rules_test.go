@@ -0,0 +1,90 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccDataSourcePagerDutyRulesetRules_Basic(t *testing.T) {
+	ruleset := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	dataSourceName := "data.pagerduty_ruleset_rules.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyRulesetRulesConfig(ruleset),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.id", "pagerduty_ruleset_rule.foo", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.position", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.disabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.conditions.0.subconditions.0.parameter.0.value", "disk space"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.actions.0.annotate.0.value", "disk space"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyRulesetRulesConfig(ruleset string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_ruleset" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_ruleset_rule" "foo" {
+  ruleset  = pagerduty_ruleset.foo.id
+  position = 0
+  disabled = true
+
+  conditions {
+    operator = "and"
+
+    subconditions {
+      operator = "contains"
+
+      parameter {
+        value = "disk space"
+        path  = "payload.summary"
+      }
+    }
+  }
+
+  actions {
+    annotate {
+      value = "disk space"
+    }
+  }
+}
+
+data "pagerduty_ruleset_rules" "foo" {
+  ruleset = pagerduty_ruleset_rule.foo.ruleset
+}
+`, ruleset)
+}
+
+func TestFlattenDataSourceRulesetRules(t *testing.T) {
+	first, second := 0, 1
+	rules := []*pagerduty.RulesetRule{
+		{ID: "catch-all", CatchAll: true},
+		{ID: "second", Position: &second},
+		{ID: "first", Position: &first},
+	}
+
+	var ids []string
+	for _, r := range flattenDataSourceRulesetRules(rules) {
+		ids = append(ids, r["id"].(string))
+	}
+
+	expected := []string{"first", "second", "catch-all"}
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, ids)
+		}
+	}
+}
@@ -26,6 +26,23 @@ func TestAccDataSourcePagerDutyRuleset_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyRuleset_RoutingKey(t *testing.T) {
+	ruleset := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyRulesetRoutingKeyConfig(ruleset),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyRuleset("pagerduty_ruleset.test", "data.pagerduty_ruleset.by_routing_key"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourcePagerDutyRuleset(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -62,3 +79,15 @@ data "pagerduty_ruleset" "by_name" {
 }
 `, ruleset)
 }
+
+func testAccDataSourcePagerDutyRulesetRoutingKeyConfig(ruleset string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_ruleset" "test" {
+  name = "%s"
+}
+
+data "pagerduty_ruleset" "by_routing_key" {
+  routing_key = pagerduty_ruleset.test.routing_keys[0]
+}
+`, ruleset)
+}
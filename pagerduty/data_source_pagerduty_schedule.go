@@ -1,7 +1,6 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -19,6 +18,45 @@ func dataSourcePagerDutySchedule() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"render": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"until": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"rendered_coverage_percentage": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"final_schedule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -57,13 +95,49 @@ func dataSourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) e
 
 		if found == nil {
 			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any schedule with the name: %s", searchName),
+				errNotFound("schedule", "name", searchName),
 			)
 		}
 
 		d.SetId(found.ID)
 		d.Set("name", found.Name)
 
+		if !d.Get("render").(bool) {
+			return nil
+		}
+
+		// Rendering is only done on request, as it's slow for large schedules
+		rendered, _, err := client.Schedules.Get(found.ID, &pagerduty.GetScheduleOptions{
+			Since: d.Get("since").(string),
+			Until: d.Get("until").(string),
+		})
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+
+		if rendered.FinalSchedule != nil {
+			d.Set("rendered_coverage_percentage", renderRoundedPercentage(rendered.FinalSchedule.RenderedCoveragePercentage))
+			if err := d.Set("final_schedule", flattenRenderedScheduleEntries(rendered.FinalSchedule.RenderedScheduleEntries)); err != nil {
+				return resource.NonRetryableError(err)
+			}
+		}
+
 		return nil
 	})
 }
+
+func flattenRenderedScheduleEntries(entries []*pagerduty.ScheduleLayerEntry) []map[string]interface{} {
+	var res []map[string]interface{}
+	for _, e := range entries {
+		entry := map[string]interface{}{
+			"start": e.Start,
+			"end":   e.End,
+		}
+		if e.User != nil {
+			entry["user_id"] = e.User.ID
+		}
+		res = append(res, entry)
+	}
+
+	return res
+}
@@ -90,3 +90,40 @@ data "pagerduty_schedule" "by_name" {
 }
 `, username, email, schedule, location, start, rotationVirtualStart)
 }
+
+func TestAccDataSourcePagerDutySchedule_Render(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	location := "Europe/Berlin"
+	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	until := timeNowInLoc(location).Add(15 * 24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyScheduleRenderConfig(username, email, schedule, location, start, rotationVirtualStart, until),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_schedule.rendered", "rendered_coverage_percentage"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_schedule.rendered", "final_schedule.0.user_id", "pagerduty_user.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyScheduleRenderConfig(username, email, schedule, location, start, rotationVirtualStart, until string) string {
+	return fmt.Sprintf(`
+%s
+
+data "pagerduty_schedule" "rendered" {
+  name   = pagerduty_schedule.test.name
+  render = true
+  since  = "%s"
+  until  = "%s"
+}
+`, testAccDataSourcePagerDutyScheduleConfig(username, email, schedule, location, start, rotationVirtualStart), start, until)
+}
@@ -1,7 +1,6 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -66,7 +65,7 @@ func dataSourcePagerDutyServiceIntegrationRead(d *schema.ResourceData, meta inte
 
 		if found == nil {
 			return resource.NonRetryableError(
-				fmt.Errorf("unable to locate any service with the name: %s", searchName),
+				errNotFound("service", "name", searchName),
 			)
 		}
 
@@ -86,7 +85,7 @@ func dataSourcePagerDutyServiceIntegrationRead(d *schema.ResourceData, meta inte
 
 		}
 		return resource.NonRetryableError(
-			fmt.Errorf("unable to locate any integration of type %s on service %s", integrationSummary, searchName),
+			errNotFound("integration", "service_name", searchName, "integration_summary", integrationSummary),
 		)
 	})
 }
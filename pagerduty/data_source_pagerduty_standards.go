@@ -0,0 +1,170 @@
+package pagerduty
+
+import (
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// standard represents a rule of the Standards API that the resources of an
+// account are scored against.
+type standard struct {
+	ID           string               `json:"id,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Description  string               `json:"description,omitempty"`
+	Active       bool                 `json:"active"`
+	Type         string               `json:"type,omitempty"`
+	ResourceType string               `json:"resource_type,omitempty"`
+	Exclusions   []*standardInclusion `json:"exclusions"`
+	Inclusions   []*standardInclusion `json:"inclusions,omitempty"`
+}
+
+type standardInclusion struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type listStandardsResponse struct {
+	Standards []*standard `json:"standards"`
+}
+
+func flattenStandardInclusions(inclusions []*standardInclusion) []interface{} {
+	var result []interface{}
+	for _, i := range inclusions {
+		result = append(result, map[string]interface{}{
+			"id":   i.ID,
+			"type": i.Type,
+		})
+	}
+
+	return result
+}
+
+func standardInclusionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyStandards() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyStandardsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validateValueFunc([]string{
+					"technical_service",
+				}),
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"standards": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exclusions": standardInclusionsSchema(),
+						"inclusions": standardInclusionsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyStandardsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty standards")
+
+	query := url.Values{}
+	if v, ok := d.GetOk("resource_type"); ok {
+		query.Set("resource_type", v.(string))
+	}
+	if v, ok := d.GetOkExists("active"); ok {
+		query.Set("active", strconv.FormatBool(v.(bool)))
+	}
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp := new(listStandardsResponse)
+		if _, err := apiRequest(client, "GET", "/standards", query, nil, resp); err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var standards []map[string]interface{}
+		for _, s := range resp.Standards {
+			standards = append(standards, map[string]interface{}{
+				"id":            s.ID,
+				"name":          s.Name,
+				"description":   s.Description,
+				"active":        s.Active,
+				"type":          s.Type,
+				"resource_type": s.ResourceType,
+				"exclusions":    flattenStandardInclusions(s.Exclusions),
+				"inclusions":    flattenStandardInclusions(s.Inclusions),
+			})
+		}
+
+		d.SetId(strconv.Itoa(schema.HashString(query.Encode())))
+		if err := d.Set("standards", standards); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,173 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceScores are the results of the standards applying to a resource.
+type resourceScores struct {
+	ResourceID   string `json:"resource_id"`
+	ResourceType string `json:"resource_type"`
+	Score        *struct {
+		Passing int `json:"passing"`
+		Total   int `json:"total"`
+	} `json:"score"`
+	Standards []*struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Active      bool   `json:"active"`
+		Pass        bool   `json:"pass"`
+		Type        string `json:"type"`
+	} `json:"standards"`
+}
+
+// standardsScoresPath maps the resource types of the data sources to the
+// path segment of the Standards API.
+func standardsScoresPath(resourceType string) string {
+	return fmt.Sprintf("/standards/scores/%ss", resourceType)
+}
+
+func resourceScoresSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"score": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"passing": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"total": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"standards": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"active": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"pass": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenResourceScores(r *resourceScores) map[string]interface{} {
+	var score []interface{}
+	if r.Score != nil {
+		score = append(score, map[string]interface{}{
+			"passing": r.Score.Passing,
+			"total":   r.Score.Total,
+		})
+	}
+
+	var standards []interface{}
+	for _, s := range r.Standards {
+		standards = append(standards, map[string]interface{}{
+			"id":          s.ID,
+			"name":        s.Name,
+			"description": s.Description,
+			"active":      s.Active,
+			"pass":        s.Pass,
+			"type":        s.Type,
+		})
+	}
+
+	return map[string]interface{}{
+		"resource_id":   r.ResourceID,
+		"resource_type": r.ResourceType,
+		"score":         score,
+		"standards":     standards,
+	}
+}
+
+func dataSourcePagerDutyStandardsResourceScores() *schema.Resource {
+	s := resourceScoresSchema()
+	s["resource_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["resource_type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "technical_service",
+		ValidateFunc: validateValueFunc([]string{
+			"technical_service",
+		}),
+	}
+
+	return &schema.Resource{
+		Read:   dataSourcePagerDutyStandardsResourceScoresRead,
+		Schema: s,
+	}
+}
+
+func dataSourcePagerDutyStandardsResourceScoresRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	id := d.Get("resource_id").(string)
+	path := fmt.Sprintf("%s/%s", standardsScoresPath(d.Get("resource_type").(string)), id)
+
+	log.Printf("[INFO] Reading PagerDuty standards scores of %s", id)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp := new(resourceScores)
+		if _, err := apiRequest(client, "GET", path, nil, nil, resp); err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		scores := flattenResourceScores(resp)
+
+		d.SetId(id)
+		d.Set("score", scores["score"])
+		if err := d.Set("standards", scores["standards"]); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,94 @@
+package pagerduty
+
+import (
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type listResourcesScoresResponse struct {
+	Resources []*resourceScores `json:"resources"`
+}
+
+func dataSourcePagerDutyStandardsResourcesScores() *schema.Resource {
+	resourceSchema := resourceScoresSchema()
+	resourceSchema["resource_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	resourceSchema["resource_type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Read: dataSourcePagerDutyStandardsResourcesScoresRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "technical_service",
+				ValidateFunc: validateValueFunc([]string{
+					"technical_service",
+				}),
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: resourceSchema},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyStandardsResourcesScoresRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	ids := expandStringList(d.Get("ids").([]interface{}))
+	query := url.Values{"ids": []string{strings.Join(ids, ",")}}
+	path := standardsScoresPath(d.Get("resource_type").(string))
+
+	log.Printf("[INFO] Reading PagerDuty standards scores of %d resources", len(ids))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp := new(listResourcesScoresResponse)
+		if _, err := apiRequest(client, "GET", path, query, nil, resp); err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var resources []map[string]interface{}
+		for _, r := range resp.Resources {
+			resources = append(resources, flattenResourceScores(r))
+		}
+
+		d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+		if err := d.Set("resources", resources); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
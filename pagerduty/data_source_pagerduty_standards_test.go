@@ -0,0 +1,32 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyStandards_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyStandardsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_standards.all", "standards.#"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_standards.active", "standards.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyStandardsConfig = `
+data "pagerduty_standards" "all" {}
+
+data "pagerduty_standards" "active" {
+  resource_type = "technical_service"
+  active        = true
+}
+`
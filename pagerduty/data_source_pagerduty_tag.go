@@ -1,7 +1,6 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -58,7 +57,7 @@ func dataSourcePagerDutyTagRead(d *schema.ResourceData, meta interface{}) error
 
 		if found == nil {
 			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any tag with label: %s", searchTag),
+				errNotFound("tag", "label", searchTag),
 			)
 		}
 
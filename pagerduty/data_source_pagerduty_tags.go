@@ -0,0 +1,87 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"label_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyTagsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty tags")
+
+	prefix := d.Get("label_prefix").(string)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		// List follows pagination until every tag has been read.
+		resp, _, err := client.Tags.List(&pagerduty.ListTagsOptions{})
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		tags := make([]map[string]interface{}, 0, len(resp.Tags))
+		for _, tag := range resp.Tags {
+			if !strings.HasPrefix(tag.Label, prefix) {
+				continue
+			}
+
+			tags = append(tags, map[string]interface{}{
+				"id":       tag.ID,
+				"label":    tag.Label,
+				"html_url": tag.HTMLURL,
+			})
+		}
+
+		d.SetId(strconv.Itoa(schema.HashString(prefix)))
+		if err := d.Set("tags", tags); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,46 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyTags_Basic(t *testing.T) {
+	prefix := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyTagsConfig(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_tags.by_prefix", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_tags.by_prefix", "tags.*.id", "pagerduty_tag.foo", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_tags.by_prefix", "tags.*.id", "pagerduty_tag.bar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyTagsConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_tag" "foo" {
+  label = "%[1]s-foo"
+}
+
+resource "pagerduty_tag" "bar" {
+  label = "%[1]s-bar"
+}
+
+data "pagerduty_tags" "by_prefix" {
+  label_prefix = "%[1]s"
+
+  depends_on = [pagerduty_tag.foo, pagerduty_tag.bar]
+}
+`, prefix)
+}
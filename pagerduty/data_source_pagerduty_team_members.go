@@ -0,0 +1,89 @@
+package pagerduty
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyTeamMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyTeamMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyTeamMembersRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	teamID := d.Get("team_id").(string)
+
+	log.Printf("[INFO] Reading PagerDuty team members of team: %s", teamID)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		// GetMembers follows pagination until every member has been read.
+		resp, _, err := client.Teams.GetMembers(teamID, &pagerduty.GetMembersOptions{})
+		if err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var members []map[string]interface{}
+		for _, m := range resp.Members {
+			if m.User == nil {
+				continue
+			}
+
+			members = append(members, map[string]interface{}{
+				"id":      m.User.ID,
+				"summary": m.User.Summary,
+				"role":    m.Role,
+			})
+		}
+
+		d.SetId(teamID)
+		if err := d.Set("members", members); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
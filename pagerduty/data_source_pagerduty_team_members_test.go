@@ -0,0 +1,54 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyTeamMembers_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyTeamMembersConfig(username, email, team),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_team_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_team_members.test", "members.0.id", "pagerduty_user.test", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_team_members.test", "members.0.summary", username),
+					resource.TestCheckResourceAttr("data.pagerduty_team_members.test", "members.0.role", "responder"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyTeamMembersConfig(username, email, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_team" "test" {
+  name = "%s"
+}
+
+resource "pagerduty_team_membership" "test" {
+  user_id = pagerduty_user.test.id
+  team_id = pagerduty_team.test.id
+  role    = "responder"
+}
+
+data "pagerduty_team_members" "test" {
+  team_id = pagerduty_team_membership.test.team_id
+}
+`, username, email, team)
+}
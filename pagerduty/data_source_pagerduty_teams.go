@@ -0,0 +1,157 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyTeams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyTeamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"include_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"members": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyTeamsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty teams")
+
+	query := d.Get("query").(string)
+	includeMembers := d.Get("include_members").(bool)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var teams []*pagerduty.Team
+
+		o := &pagerduty.ListTeamsOptions{Query: query}
+		for {
+			resp, _, err := client.Teams.List(o)
+			if err != nil {
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			teams = append(teams, resp.Teams...)
+
+			if !resp.More {
+				break
+			}
+			o.Offset = resp.Offset + resp.Limit
+		}
+
+		var members [][]string
+		if includeMembers {
+			members = make([][]string, len(teams))
+			err := forEachParallel(config, len(teams), func(i int) error {
+				resp, _, err := client.Teams.GetMembers(teams[i].ID, &pagerduty.GetMembersOptions{})
+				if err != nil {
+					return err
+				}
+				for _, m := range resp.Members {
+					if m.User != nil {
+						members[i] = append(members[i], m.User.ID)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+		}
+
+		id := query
+		if includeMembers {
+			id = fmt.Sprintf("%s|members", query)
+		}
+
+		d.SetId(strconv.Itoa(schema.HashString(id)))
+		if err := d.Set("teams", flattenDataSourceTeams(teams, members)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// flattenDataSourceTeams flattens the teams along with the IDs of their
+// members, which are only known when include_members is set.
+func flattenDataSourceTeams(teams []*pagerduty.Team, members [][]string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(teams))
+	for i, t := range teams {
+		parent := ""
+		if t.Parent != nil {
+			parent = t.Parent.ID
+		}
+
+		team := map[string]interface{}{
+			"id":          t.ID,
+			"name":        t.Name,
+			"description": t.Description,
+			"parent":      parent,
+		}
+		if members != nil {
+			team["members"] = members[i]
+		}
+
+		result = append(result, team)
+	}
+
+	return result
+}
@@ -0,0 +1,95 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyTeams_Basic(t *testing.T) {
+	parent := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	child := fmt.Sprintf("%s-child", parent)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyTeamsConfig(parent, child),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_teams.by_query", "teams.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_teams.by_query", "teams.0.id", "pagerduty_team.child", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_teams.by_query", "teams.0.name", child),
+					resource.TestCheckResourceAttr("data.pagerduty_teams.by_query", "teams.0.description", "child"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_teams.by_query", "teams.0.parent", "pagerduty_team.parent", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyTeamsConfig(parent, child string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "parent" {
+  name = "%s"
+}
+
+resource "pagerduty_team" "child" {
+  name        = "%s"
+  description = "child"
+  parent      = pagerduty_team.parent.id
+}
+
+data "pagerduty_teams" "by_query" {
+  query = pagerduty_team.child.name
+}
+`, parent, child)
+}
+
+func TestAccDataSourcePagerDutyTeams_IncludeMembers(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyTeamsIncludeMembersConfig(team, username, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_teams.with_members", "teams.#", "1"),
+					resource.TestCheckResourceAttr("data.pagerduty_teams.with_members", "teams.0.members.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_teams.with_members", "teams.0.members.0", "pagerduty_user.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyTeamsIncludeMembersConfig(team, username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_team_membership" "foo" {
+  user_id = pagerduty_user.foo.id
+  team_id = pagerduty_team.foo.id
+}
+
+data "pagerduty_teams" "with_members" {
+  query           = pagerduty_team.foo.name
+  include_members = true
+
+  depends_on = [pagerduty_team_membership.foo]
+}
+`, team, username, email)
+}
@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -23,12 +24,25 @@ func dataSourcePagerDutyUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"exact_match": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"team_roles": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
 
 func dataSourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
@@ -36,6 +50,7 @@ func dataSourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Reading PagerDuty user")
 
 	searchEmail := d.Get("email").(string)
+	exactMatch := d.Get("exact_match").(bool)
 
 	o := &pagerduty.ListUsersOptions{
 		Query: searchEmail,
@@ -50,25 +65,48 @@ func dataSourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error
 			return resource.RetryableError(err)
 		}
 
-		var found *pagerduty.FullUser
+		var candidates []*pagerduty.FullUser
 
 		for _, user := range resp {
-			if user.Email == searchEmail {
-				found = user
-				break
+			if !exactMatch || strings.EqualFold(user.Email, searchEmail) {
+				candidates = append(candidates, user)
 			}
 		}
 
-		if found == nil {
+		if len(candidates) == 0 {
+			return resource.NonRetryableError(
+				errNotFound("user", "email", searchEmail),
+			)
+		}
+
+		if len(candidates) > 1 {
+			var emails []string
+			for _, c := range candidates {
+				emails = append(emails, fmt.Sprintf("%s (%s)", c.Email, c.ID))
+			}
 			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any user with the email: %s", searchEmail),
+				fmt.Errorf("Found %d users matching the email %s, please use a more specific email: %s", len(candidates), searchEmail, strings.Join(emails, ", ")),
 			)
 		}
 
+		found := candidates[0]
+
 		d.SetId(found.ID)
 		d.Set("name", found.Name)
 		d.Set("email", found.Email)
 
+		var teamIDs []string
+		for _, t := range found.Teams {
+			teamIDs = append(teamIDs, t.ID)
+		}
+
+		teamRoles, err := fetchUserTeamRoles(config, client, found.ID, teamIDs)
+		if err != nil {
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+		d.Set("team_roles", teamRoles)
+
 		return nil
 	})
 }
@@ -1,7 +1,6 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -20,9 +19,11 @@ func dataSourcePagerDutyUserContactMethod() *schema.Resource {
 				Required: true,
 			},
 			"label": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the contact method to find in the PagerDuty API",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The name of the contact method to find in the PagerDuty API",
+				AtLeastOneOf: []string{"label", "address"},
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -31,8 +32,11 @@ func dataSourcePagerDutyUserContactMethod() *schema.Resource {
 			},
 
 			"address": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The address of the contact method to find in the PagerDuty API",
+				AtLeastOneOf: []string{"label", "address"},
 			},
 			"blacklisted": {
 				Type:     schema.TypeBool,
@@ -68,6 +72,7 @@ func dataSourcePagerDutyUserContactMethodRead(d *schema.ResourceData, meta inter
 
 	userId := d.Get("user_id").(string)
 	searchLabel := d.Get("label").(string)
+	searchAddress := d.Get("address").(string)
 	searchType := d.Get("type").(string)
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -85,15 +90,23 @@ func dataSourcePagerDutyUserContactMethodRead(d *schema.ResourceData, meta inter
 		var found *pagerduty.ContactMethod
 
 		for _, contactMethod := range resp.ContactMethods {
-			if contactMethod.Label == searchLabel &&
-				contactMethod.Type == searchType {
-				found = contactMethod
-				break
+			if contactMethod.Type != searchType {
+				continue
+			}
+			if searchLabel != "" && contactMethod.Label != searchLabel {
+				continue
+			}
+			if searchAddress != "" && contactMethod.Address != searchAddress {
+				continue
 			}
+			found = contactMethod
+			break
 		}
 
 		if found == nil {
-			return resource.NonRetryableError(fmt.Errorf("Unable to locate any contact methods with the label: %s", searchLabel))
+			return resource.NonRetryableError(
+				errNotFound("contact method", "user_id", userId, "type", searchType, "label", searchLabel, "address", searchAddress),
+			)
 		}
 
 		d.SetId(found.ID)
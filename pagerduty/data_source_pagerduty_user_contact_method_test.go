@@ -24,6 +24,7 @@ func TestAccDataSourcePagerDutyUserContactMethod_Basic(t *testing.T) {
 				Config: testAccDataSourcePagerDutyUserContactMethodConfig(name, method_type, address, second_address, label),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourcePagerDutyUserContactMethod("pagerduty_user_contact_method.test", "data.pagerduty_user_contact_method.by_summary_type_and_user_id"),
+					testAccDataSourcePagerDutyUserContactMethod("pagerduty_user_contact_method.test", "data.pagerduty_user_contact_method.by_address_type_and_user_id"),
 				),
 			},
 		},
@@ -77,5 +78,11 @@ data "pagerduty_user_contact_method" "by_summary_type_and_user_id" {
   user_id = pagerduty_user.foo.id
   type = "%[2]s"
 }
+
+data "pagerduty_user_contact_method" "by_address_type_and_user_id" {
+  address = pagerduty_user_contact_method.test.address
+  user_id = pagerduty_user.foo.id
+  type    = "%[2]s"
+}
 `, name, method_type, address, second_address, label)
 }
@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -27,6 +28,56 @@ func TestAccDataSourcePagerDutyUser_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyUser_ExactMatch(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	prefixedEmail := fmt.Sprintf("other.%s", email)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyUserExactMatchConfig(username, email, prefixedEmail, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyUser("pagerduty_user.test", "data.pagerduty_user.by_email"),
+				),
+			},
+			{
+				Config:      testAccDataSourcePagerDutyUserExactMatchConfig(username, email, prefixedEmail, false),
+				ExpectError: regexp.MustCompile("Found 2 users matching the email"),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutyUser_TeamRoles(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyUserTeamRolesConfig(username, email, team),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_user.by_email", "team_roles.%", "1"),
+					testAccCheckPagerDutyUserTeamRole("data.pagerduty_user.by_email", "pagerduty_team.test", "responder"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyUserTeamRole(n, team, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		teamID := s.RootModule().Resources[team].Primary.ID
+		return resource.TestCheckResourceAttr(n, fmt.Sprintf("team_roles.%s", teamID), role)(s)
+	}
+}
+
 func testAccDataSourcePagerDutyUser(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -64,3 +115,48 @@ data "pagerduty_user" "by_email" {
 }
 `, username, email)
 }
+
+func testAccDataSourcePagerDutyUserExactMatchConfig(username, email, prefixedEmail string, exactMatch bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%[1]s"
+  email = "%[2]s"
+}
+
+resource "pagerduty_user" "other" {
+  name  = "%[1]s-other"
+  email = "%[3]s"
+}
+
+data "pagerduty_user" "by_email" {
+  email       = pagerduty_user.test.email
+  exact_match = %[4]t
+  depends_on  = [pagerduty_user.other]
+}
+`, username, email, prefixedEmail, exactMatch)
+}
+
+func testAccDataSourcePagerDutyUserTeamRolesConfig(username, email, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_team" "test" {
+  name = "%s"
+}
+
+resource "pagerduty_team_membership" "test" {
+  user_id = pagerduty_user.test.id
+  team_id = pagerduty_team.test.id
+  role    = "responder"
+}
+
+data "pagerduty_user" "by_email" {
+  email = pagerduty_user.test.email
+
+  depends_on = [pagerduty_team_membership.test]
+}
+`, username, email, team)
+}
@@ -0,0 +1,198 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"team_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validateValueFunc([]string{
+						"contact_methods",
+						"notification_rules",
+						"teams",
+					}),
+				},
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"teams": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"contact_methods": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"notification_rules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyUsersRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty users")
+
+	query := d.Get("query").(string)
+	teamIDs := expandStringList(d.Get("team_ids").([]interface{}))
+	include := expandStringList(d.Get("include").([]interface{}))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, err := listUsers(client, query, teamIDs, include)
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(dataSourcePagerDutyUsersID(query, teamIDs, include))
+		if err := d.Set("users", flattenDataSourceUsers(resp)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// listUsers lists the users page by page rather than through the client, so
+// that listing past the 10000 users the API can page through fails clearly.
+func listUsers(client *pagerduty.Client, query string, teamIDs, include []string) ([]*pagerduty.FullUser, error) {
+	q := url.Values{}
+	if query != "" {
+		q.Set("query", query)
+	}
+	for _, id := range teamIDs {
+		q.Add("team_ids[]", id)
+	}
+	for _, i := range include {
+		q.Add("include[]", i)
+	}
+
+	var users []*pagerduty.FullUser
+	err := listPages(client, "/users", q, func(raw json.RawMessage) (int, bool, error) {
+		resp := new(pagerduty.ListFullUsersResponse)
+		if err := json.Unmarshal(raw, resp); err != nil {
+			return 0, false, err
+		}
+
+		users = append(users, resp.Users...)
+		return len(resp.Users), true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// dataSourcePagerDutyUsersID derives a stable ID from the filters used to
+// list users.
+func dataSourcePagerDutyUsersID(query string, teamIDs, include []string) string {
+	key := fmt.Sprintf("%s|%s|%s", query, strings.Join(teamIDs, ","), strings.Join(include, ","))
+	return strconv.Itoa(schema.HashString(key))
+}
+
+func flattenDataSourceUsers(users []*pagerduty.FullUser) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(users))
+	for _, u := range users {
+		var teams, contactMethods, notificationRules []string
+		for _, t := range u.Teams {
+			teams = append(teams, t.ID)
+		}
+		for _, c := range u.ContactMethods {
+			contactMethods = append(contactMethods, c.ID)
+		}
+		for _, r := range u.NotificationRules {
+			notificationRules = append(notificationRules, r.ID)
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                 u.ID,
+			"name":               u.Name,
+			"email":              u.Email,
+			"role":               u.Role,
+			"job_title":          u.JobTitle,
+			"time_zone":          u.TimeZone,
+			"teams":              teams,
+			"contact_methods":    contactMethods,
+			"notification_rules": notificationRules,
+		})
+	}
+
+	return result
+}
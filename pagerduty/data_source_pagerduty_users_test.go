@@ -0,0 +1,60 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyUsers_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyUsersConfig(username, email, team),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_users.by_team", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_users.by_team", "users.0.id", "pagerduty_user.test", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_users.by_team", "users.0.email", email),
+					resource.TestCheckResourceAttr("data.pagerduty_users.by_team", "users.0.role", "user"),
+					resource.TestCheckResourceAttr("data.pagerduty_users.by_query", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.pagerduty_users.by_query", "users.0.name", username),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyUsersConfig(username, email, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_team" "test" {
+  name = "%s"
+}
+
+resource "pagerduty_team_membership" "test" {
+  user_id = pagerduty_user.test.id
+  team_id = pagerduty_team.test.id
+}
+
+data "pagerduty_users" "by_team" {
+  team_ids = [pagerduty_team_membership.test.team_id]
+  include  = ["teams"]
+}
+
+data "pagerduty_users" "by_query" {
+  query = pagerduty_user.test.email
+}
+`, username, email, team)
+}
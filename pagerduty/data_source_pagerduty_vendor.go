@@ -1,10 +1,7 @@
 package pagerduty
 
 import (
-	"fmt"
 	"log"
-	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -21,6 +18,11 @@ func dataSourcePagerDutyVendor() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"exact_match": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -30,7 +32,8 @@ func dataSourcePagerDutyVendor() *schema.Resource {
 }
 
 func dataSourcePagerDutyVendorRead(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
@@ -38,12 +41,11 @@ func dataSourcePagerDutyVendorRead(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[INFO] Reading PagerDuty vendor")
 
 	searchName := d.Get("name").(string)
+	exactMatch := d.Get("exact_match").(bool)
 
-	o := &pagerduty.ListVendorsOptions{
-		Query: searchName,
-	}
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		resp, _, err := client.Vendors.List(o)
+		// The vendors are listed once per run and shared by every lookup.
+		vendors, err := config.catalogs.listVendors(client)
 		if err != nil {
 			// Delaying retry by 30s as recommended by PagerDuty
 			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
@@ -51,29 +53,20 @@ func dataSourcePagerDutyVendorRead(d *schema.ResourceData, meta interface{}) err
 			return resource.RetryableError(err)
 		}
 
-		var found *pagerduty.Vendor
-
-		for _, vendor := range resp.Vendors {
-			if strings.EqualFold(vendor.Name, searchName) {
-				found = vendor
-				break
-			}
+		names := make([]string, len(vendors))
+		for i, vendor := range vendors {
+			names[i] = vendor.Name
 		}
 
-		// We didn't find an exact match, so let's fallback to partial matching.
-		if found == nil {
-			pr := regexp.MustCompile("(?i)" + searchName)
-			for _, vendor := range resp.Vendors {
-				if pr.MatchString(vendor.Name) {
-					found = vendor
-					break
-				}
-			}
+		// Unless an exact match is required, fallback to partial matching.
+		var found *pagerduty.Vendor
+		if i := bestNameMatch(searchName, names, exactMatch); i != -1 {
+			found = vendors[i]
 		}
 
 		if found == nil {
 			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any vendor with the name: %s", searchName),
+				errNotFound("vendor", "name", searchName),
 			)
 		}
 
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -60,6 +61,19 @@ func TestAccDataSourcePagerDutyVendor_SpecialChars(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyVendor_ExactMatchRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourcePagerDutyExactMatchRequiredConfig,
+				ExpectError: regexp.MustCompile("Unable to locate any vendor with the name: cloudwatch"),
+			},
+		},
+	})
+}
+
 const testAccDataSourcePagerDutyVendorConfig = `
 data "pagerduty_vendor" "foo" {
   name = "cloudwatch"
@@ -77,3 +91,10 @@ data "pagerduty_vendor" "foo" {
   name = "Slack to PagerDuty (Legacy)"
 }
 `
+
+const testAccDataSourcePagerDutyExactMatchRequiredConfig = `
+data "pagerduty_vendor" "foo" {
+  name        = "cloudwatch"
+  exact_match = true
+}
+`
@@ -0,0 +1,96 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyVendors() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyVendorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vendors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyVendorsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty vendors")
+
+	name := d.Get("name").(string)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		// The vendors are listed once per run and shared by every lookup.
+		vendors, err := config.catalogs.listVendors(client)
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(strconv.Itoa(schema.HashString(name)))
+		if err := d.Set("vendors", flattenDataSourceVendors(vendors, name)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// flattenDataSourceVendors flattens the vendors whose name matches name the
+// way the pagerduty_vendor data source matches it partially, or every vendor
+// when name is empty.
+func flattenDataSourceVendors(vendors []*pagerduty.Vendor, name string) []map[string]interface{} {
+	pattern := namePattern(name)
+
+	result := make([]map[string]interface{}, 0, len(vendors))
+	for _, v := range vendors {
+		if !pattern.MatchString(v.Name) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":   v.ID,
+			"name": v.Name,
+			"type": v.GenericServiceType,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,68 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccDataSourcePagerDutyVendors_Name(t *testing.T) {
+	dataSourceName := "data.pagerduty_vendors.foo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyVendorsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "vendors.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "vendors.*", map[string]string{
+						"id":   "PZQ6AUS",
+						"name": "Amazon CloudWatch",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyVendorsConfig = `
+data "pagerduty_vendors" "foo" {
+  name = "cloudwatch"
+}
+`
+
+func TestFlattenDataSourceVendors(t *testing.T) {
+	vendors := []*pagerduty.Vendor{
+		{ID: "P1", Name: "Amazon CloudWatch", GenericServiceType: "api"},
+		{ID: "P2", Name: "Datadog", GenericServiceType: "api"},
+		{ID: "P3", Name: "Slack to PagerDuty (Legacy)", GenericServiceType: "email"},
+	}
+
+	cases := []struct {
+		name string
+		ids  []string
+	}{
+		{"", []string{"P1", "P2", "P3"}},
+		{"cloudwatch", []string{"P1"}},
+		{"^d", []string{"P2"}},
+		{"(Legacy", []string{"P3"}},
+		{"nagios", nil},
+	}
+
+	for _, c := range cases {
+		var ids []string
+		for _, v := range flattenDataSourceVendors(vendors, c.name) {
+			ids = append(ids, v["id"].(string))
+		}
+		if len(ids) != len(c.ids) {
+			t.Fatalf("name %q: expected %v, got %v", c.name, c.ids, ids)
+		}
+		for i := range ids {
+			if ids[i] != c.ids[i] {
+				t.Fatalf("name %q: expected %v, got %v", c.name, c.ids, ids)
+			}
+		}
+	}
+}
@@ -3,6 +3,9 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"log"
+	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -36,8 +39,9 @@ var eventOrchestrationPathVariablesSchema = map[string]*schema.Schema{
 
 var eventOrchestrationPathExtractionsSchema = map[string]*schema.Schema{
 	"regex": {
-		Type:     schema.TypeString,
-		Optional: true,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateRegex,
 	},
 	"source": {
 		Type:     schema.TypeString,
@@ -77,13 +81,23 @@ func invalidExtractionRegexNilSourceConfig() string {
 		}`
 }
 
-func validateEventOrchestrationPathSeverity() schema.SchemaValidateFunc {
-	return validateValueFunc([]string{
-		"info",
-		"error",
-		"warning",
-		"critical",
-	})
+func invalidExtractionRegexSyntaxConfig() string {
+	return `
+		extraction {
+			regex = "host-(\\d+"
+			source = "event.summary"
+			target = "event.custom_details.host"
+		}`
+}
+
+func invalidVariableRegexSyntaxConfig() string {
+	return `
+		variable {
+			name = "hostname"
+			path = "event.summary"
+			type = "regex"
+			value = "[a-z"
+		}`
 }
 
 func validateEventOrchestrationPathEventAction() schema.SchemaValidateFunc {
@@ -108,6 +122,145 @@ func checkExtractions(context context.Context, diff *schema.ResourceDiff, i inte
 	return checkExtractionAttributes(diff, "catch_all.0.actions.0.extraction")
 }
 
+// logEventOrchestrationRuleChanges logs which rules of each set are added,
+// removed or changed by the plan. The nested diff of a large rule set is hard
+// to review on its own, especially when the rules were edited in the UI.
+func logEventOrchestrationRuleChanges(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("set") {
+		return nil
+	}
+
+	o, n := diff.GetChange("set")
+	if summary := summarizeEventOrchestrationRuleChanges(o.([]interface{}), n.([]interface{})); summary != "" {
+		log.Printf("[WARN] Event Orchestration path %s rules differ from the configuration: %s", diff.Id(), summary)
+	}
+	return nil
+}
+
+// summarizeEventOrchestrationRuleChanges compares the rules of each set by
+// position, as rules are evaluated in order, and names them by label or ID.
+func summarizeEventOrchestrationRuleChanges(oldSets, newSets []interface{}) string {
+	oldRules := eventOrchestrationRulesBySet(oldSets)
+	newRules := eventOrchestrationRulesBySet(newSets)
+
+	var setIDs []string
+	seen := make(map[string]bool)
+	for _, s := range append(oldSets, newSets...) {
+		id := s.(map[string]interface{})["id"].(string)
+		if !seen[id] {
+			seen[id] = true
+			setIDs = append(setIDs, id)
+		}
+	}
+
+	var summaries []string
+	for _, id := range setIDs {
+		var added, removed, changed []string
+		o, n := oldRules[id], newRules[id]
+
+		for ri := 0; ri < len(o) || ri < len(n); ri++ {
+			switch {
+			case ri >= len(o):
+				added = append(added, eventOrchestrationRuleName(n[ri], ri))
+			case ri >= len(n):
+				removed = append(removed, eventOrchestrationRuleName(o[ri], ri))
+			case !reflect.DeepEqual(withoutRuleID(o[ri]), withoutRuleID(n[ri])):
+				changed = append(changed, eventOrchestrationRuleName(o[ri], ri))
+			}
+		}
+
+		if len(added)+len(removed)+len(changed) == 0 {
+			continue
+		}
+
+		summary := fmt.Sprintf("set %q:", id)
+		for _, c := range []struct {
+			verb  string
+			rules []string
+		}{{"added", added}, {"removed", removed}, {"changed", changed}} {
+			if len(c.rules) > 0 {
+				summary += fmt.Sprintf(" %d %s (%s)", len(c.rules), c.verb, strings.Join(c.rules, ", "))
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return strings.Join(summaries, "; ")
+}
+
+func eventOrchestrationRulesBySet(sets []interface{}) map[string][]map[string]interface{} {
+	res := make(map[string][]map[string]interface{})
+	for _, s := range sets {
+		set := s.(map[string]interface{})
+		var rules []map[string]interface{}
+		if v, ok := set["rule"].([]interface{}); ok {
+			for _, r := range v {
+				rules = append(rules, r.(map[string]interface{}))
+			}
+		}
+		res[set["id"].(string)] = rules
+	}
+	return res
+}
+
+// withoutRuleID drops the computed rule ID, which is unknown for rules that
+// shift position in the plan.
+func withoutRuleID(rule map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(rule))
+	for k, v := range rule {
+		if k != "id" {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+func eventOrchestrationRuleName(rule map[string]interface{}, index int) string {
+	if label, _ := rule["label"].(string); label != "" {
+		return fmt.Sprintf("%q", label)
+	}
+	if id, _ := rule["id"].(string); id != "" {
+		return id
+	}
+	return fmt.Sprintf("#%d", index)
+}
+
+// checkVariables checks the regular expressions of the variables of each rule
+// and of the catch all, as their values are only regular expressions when
+// their type is regex.
+func checkVariables(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	sn := diff.Get("set.#").(int)
+
+	for si := 0; si < sn; si++ {
+		rn := diff.Get(fmt.Sprintf("set.%d.rule.#", si)).(int)
+		for ri := 0; ri < rn; ri++ {
+			if err := checkVariableAttributes(diff, fmt.Sprintf("set.%d.rule.%d.actions.0.variable", si, ri)); err != nil {
+				return err
+			}
+		}
+	}
+	return checkVariableAttributes(diff, "catch_all.0.actions.0.variable")
+}
+
+func checkVariableAttributes(diff *schema.ResourceDiff, loc string) error {
+	num := diff.Get(fmt.Sprintf("%s.#", loc)).(int)
+	for i := 0; i < num; i++ {
+		prefix := fmt.Sprintf("%s.%d", loc, i)
+		if diff.Get(fmt.Sprintf("%s.type", prefix)).(string) != "regex" {
+			continue
+		}
+
+		key := fmt.Sprintf("%s.value", prefix)
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		if _, errs := validateRegex(diff.Get(key), key); len(errs) > 0 {
+			return errs[0]
+		}
+	}
+	return nil
+}
+
 func checkExtractionAttributes(diff *schema.ResourceDiff, loc string) error {
 	num := diff.Get(fmt.Sprintf("%s.#", loc)).(int)
 	for i := 0; i < num; i++ {
@@ -0,0 +1,53 @@
+package pagerduty
+
+import "testing"
+
+func TestSummarizeEventOrchestrationRuleChanges(t *testing.T) {
+	rule := func(id, label, expression string) interface{} {
+		return map[string]interface{}{
+			"id":        id,
+			"label":     label,
+			"condition": []interface{}{map[string]interface{}{"expression": expression}},
+		}
+	}
+	set := func(id string, rules ...interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"id": id, "rule": rules}}
+	}
+
+	cases := []struct {
+		name     string
+		old, new []interface{}
+		expected string
+	}{
+		{
+			name:     "unchanged",
+			old:      set("start", rule("P1", "foo", "a")),
+			new:      set("start", rule("", "foo", "a")),
+			expected: "",
+		},
+		{
+			name:     "changed and added",
+			old:      set("start", rule("P1", "foo", "a")),
+			new:      set("start", rule("P1", "foo", "b"), rule("", "bar", "c")),
+			expected: `set "start": 1 added ("bar") 1 changed ("foo")`,
+		},
+		{
+			name:     "removed without label",
+			old:      set("start", rule("P1", "foo", "a"), rule("P2", "", "b")),
+			new:      set("start", rule("P1", "foo", "a")),
+			expected: `set "start": 1 removed (P2)`,
+		},
+		{
+			name:     "set removed",
+			old:      append(set("start"), set("child", rule("P3", "baz", "d"))...),
+			new:      set("start"),
+			expected: `set "child": 1 removed ("baz")`,
+		},
+	}
+
+	for _, c := range cases {
+		if got := summarizeEventOrchestrationRuleChanges(c.old, c.new); got != c.expected {
+			t.Errorf("%s: expected %q, got %q", c.name, c.expected, got)
+		}
+	}
+}
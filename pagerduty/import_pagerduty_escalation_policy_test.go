@@ -30,3 +30,27 @@ func TestAccPagerDutyEscalationPolicy_import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyEscalationPolicy_importByName(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf %s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyConfig(username, email, escalationPolicy),
+			},
+
+			{
+				ResourceName:      "pagerduty_escalation_policy.foo",
+				ImportState:       true,
+				ImportStateId:     escalationPolicy,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -51,3 +51,25 @@ func TestAccPagerDutyEventOrchestrationNameOnly_import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyEventOrchestration_importByName(t *testing.T) {
+	name := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationConfigNameOnly(name),
+			},
+
+			{
+				ResourceName:      "pagerduty_event_orchestration.foo",
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
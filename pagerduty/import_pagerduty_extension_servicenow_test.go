@@ -23,9 +23,10 @@ func TestAccPagerDutyExtensionServiceNow_import(t *testing.T) {
 			},
 
 			{
-				ResourceName:      "pagerduty_extension_servicenow.foo",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "pagerduty_extension_servicenow.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"snow_password"},
 			},
 		},
 	})
@@ -0,0 +1,30 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPagerDutyExtensionZendesk_import(t *testing.T) {
+	extension_name := resource.PrefixedUniqueId("tf-")
+	name := resource.PrefixedUniqueId("tf-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyExtensionZendeskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyExtensionZendeskConfig(name, extension_name, "two_way"),
+			},
+
+			{
+				ResourceName:            "pagerduty_extension_zendesk.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zendesk_api_token"},
+			},
+		},
+	})
+}
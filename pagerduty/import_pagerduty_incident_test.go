@@ -0,0 +1,48 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyIncident_import(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	title := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentConfig(username, email, escalationPolicy, service, title, "high"),
+			},
+			{
+				ResourceName:            "pagerduty_incident.foo",
+				ImportStateIdFunc:       testAccCheckPagerDutyIncidentID,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resolution"},
+			},
+			{
+				ResourceName: "pagerduty_incident.foo",
+				ImportState:  true,
+				ExpectError:  regexp.MustCompile("Expecting an importation ID formed as '<incident_id>:<from_email>'"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentID(s *terraform.State) (string, error) {
+	ua := s.RootModule().Resources["pagerduty_incident.foo"].Primary.Attributes
+
+	return fmt.Sprintf("%v:%v", s.RootModule().Resources["pagerduty_incident.foo"].Primary.ID, ua["from"]), nil
+}
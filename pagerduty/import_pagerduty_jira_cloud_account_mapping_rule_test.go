@@ -0,0 +1,46 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyJiraCloudAccountMappingRule_import(t *testing.T) {
+	subdomain := os.Getenv("PAGERDUTY_ACC_JIRA_CLOUD_SUBDOMAIN")
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	rule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckJiraCloud(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyJiraCloudAccountMappingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyJiraCloudAccountMappingRuleConfig(subdomain, username, email, escalationPolicy, service, rule, true),
+			},
+			{
+				ResourceName:      "pagerduty_jira_cloud_account_mapping_rule.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyJiraCloudAccountMappingRuleID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyJiraCloudAccountMappingRuleID(s *terraform.State) (string, error) {
+	rs := s.RootModule().Resources["pagerduty_jira_cloud_account_mapping_rule.foo"]
+
+	return fmt.Sprintf("%v:%v", rs.Primary.Attributes["account_mapping"], rs.Primary.ID), nil
+}
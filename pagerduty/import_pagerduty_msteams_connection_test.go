@@ -0,0 +1,40 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyMSTeamsConnection_import(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckMSTeamsConnection(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMSTeamsConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyMSTeamsConnectionConfig(team, "high"),
+			},
+			{
+				ResourceName:      "pagerduty_msteams_connection.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyMSTeamsConnectionID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyMSTeamsConnectionID(s *terraform.State) (string, error) {
+	rs := s.RootModule().Resources["pagerduty_msteams_connection.foo"]
+
+	return fmt.Sprintf("%v:%v", rs.Primary.Attributes["tenant_id"], rs.Primary.ID), nil
+}
@@ -27,6 +27,12 @@ func TestAccPagerDutyResponsePlay_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "pagerduty_response_play.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyResponsePlayLegacyID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -34,5 +40,11 @@ func TestAccPagerDutyResponsePlay_import(t *testing.T) {
 func testAccCheckPagerDutyResponsePlayID(s *terraform.State) (string, error) {
 	ua := s.RootModule().Resources["pagerduty_response_play.foo"].Primary.Attributes
 
+	return fmt.Sprintf("%v:%v", s.RootModule().Resources["pagerduty_response_play.foo"].Primary.ID, ua["from"]), nil
+}
+
+func testAccCheckPagerDutyResponsePlayLegacyID(s *terraform.State) (string, error) {
+	ua := s.RootModule().Resources["pagerduty_response_play.foo"].Primary.Attributes
+
 	return fmt.Sprintf("%v.%v", s.RootModule().Resources["pagerduty_response_play.foo"].Primary.ID, ua["from"]), nil
 }
@@ -36,3 +36,30 @@ func TestAccPagerDutyRulesetRule_import(t *testing.T) {
 func testAccCheckPagerDutyRulesetRuleID(s *terraform.State) (string, error) {
 	return fmt.Sprintf("%v.%v", s.RootModule().Resources["pagerduty_ruleset.foo"].Primary.ID, s.RootModule().Resources["pagerduty_ruleset_rule.foo"].Primary.ID), nil
 }
+
+func TestAccPagerDutyRulesetRule_importCatchAll(t *testing.T) {
+	ruleset := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	rule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	catchAllRule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyRulesetRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigCatchAllRule(team, ruleset, rule, catchAllRule),
+			},
+
+			{
+				ResourceName: "pagerduty_ruleset_rule.catch_all",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%v:%v", s.RootModule().Resources["pagerduty_ruleset.foo"].Primary.ID, s.RootModule().Resources["pagerduty_ruleset_rule.catch_all"].Primary.ID), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
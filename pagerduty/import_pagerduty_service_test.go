@@ -55,3 +55,28 @@ func TestAccPagerDutyServiceWithIncidentUrgency_import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyService_importByName(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceConfig(username, email, escalationPolicy, service),
+			},
+
+			{
+				ResourceName:      "pagerduty_service.foo",
+				ImportState:       true,
+				ImportStateId:     "name:" + service,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPagerDutyStandard_import(t *testing.T) {
+	description := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyStandardConfig(description, true),
+			},
+
+			{
+				ResourceName:      "pagerduty_standard.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
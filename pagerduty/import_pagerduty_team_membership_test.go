@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -52,3 +53,26 @@ func TestAccPagerDutyTeamMembership_importWithRole(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyTeamMembership_importMalformedID(t *testing.T) {
+	user := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamMembershipConfig(user, team),
+			},
+
+			{
+				ResourceName:  "pagerduty_team_membership.foo",
+				ImportState:   true,
+				ImportStateId: "PLBP09X",
+				ExpectError:   regexp.MustCompile("Expecting an importation ID formed as '<user_id>:<team_id>'"),
+			},
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPagerDutyTeamMemberships_import(t *testing.T) {
+	user1 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	user2 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamMembershipsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamMembershipsConfig(user1, user2, team),
+			},
+
+			{
+				ResourceName:      "pagerduty_team_memberships.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
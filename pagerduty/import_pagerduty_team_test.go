@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -28,3 +29,31 @@ func TestAccPagerDutyTeam_import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyTeam_importByName(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamConfig(team),
+			},
+
+			{
+				ResourceName:      "pagerduty_team.foo",
+				ImportState:       true,
+				ImportStateId:     "name:" + team,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "pagerduty_team.foo",
+				ImportState:   true,
+				ImportStateId: "name:" + team + "-missing",
+				ExpectError:   regexp.MustCompile("No team found with the name"),
+			},
+		},
+	})
+}
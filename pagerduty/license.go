@@ -0,0 +1,169 @@
+package pagerduty
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// license represents a license purchased by the account, as returned by the
+// Licenses API.
+type license struct {
+	ID                   string   `json:"id,omitempty"`
+	Type                 string   `json:"type,omitempty"`
+	Name                 string   `json:"name,omitempty"`
+	Summary              string   `json:"summary,omitempty"`
+	Description          string   `json:"description,omitempty"`
+	RoleGroup            string   `json:"role_group,omitempty"`
+	ValidRoles           []string `json:"valid_roles,omitempty"`
+	CurrentValue         int      `json:"current_value"`
+	AllocationsAvailable *int     `json:"allocations_available,omitempty"`
+	HTMLURL              string   `json:"html_url,omitempty"`
+	Self                 string   `json:"self,omitempty"`
+}
+
+// permitsRole reports whether a user with the given role can hold the license.
+func (l *license) permitsRole(role string) bool {
+	for _, r := range l.ValidRoles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+type licenseReference struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+type listLicensesResponse struct {
+	Licenses []*license `json:"licenses,omitempty"`
+}
+
+type licensePayload struct {
+	License *license `json:"license,omitempty"`
+}
+
+// listLicenses lists the licenses purchased by the account.
+func listLicenses(client *pagerduty.Client) ([]*license, error) {
+	v := new(listLicensesResponse)
+	if _, err := apiRequest(client, "GET", "/licenses", nil, nil, v); err != nil {
+		return nil, err
+	}
+
+	return v.Licenses, nil
+}
+
+// getUserLicense retrieves the license allocated to a user.
+func getUserLicense(client *pagerduty.Client, userID string) (*license, error) {
+	v := new(licensePayload)
+	if _, err := apiRequest(client, "GET", fmt.Sprintf("/users/%s/license", userID), nil, nil, v); err != nil {
+		return nil, err
+	}
+
+	return v.License, nil
+}
+
+// assignUserLicense allocates the given license to a user.
+func assignUserLicense(client *pagerduty.Client, userID, licenseID string) error {
+	payload := map[string]interface{}{
+		"user": map[string]interface{}{
+			"type":    "user",
+			"license": &licenseReference{ID: licenseID, Type: "license_reference"},
+		},
+	}
+
+	_, err := apiRequest(client, "PUT", fmt.Sprintf("/users/%s", userID), nil, payload, nil)
+	return err
+}
+
+// validateLicenseRole makes sure the given role can be assigned to a user
+// holding the license with the given ID.
+func validateLicenseRole(licenses []*license, licenseID, role string) error {
+	for _, l := range licenses {
+		if l.ID != licenseID {
+			continue
+		}
+
+		if l.permitsRole(role) {
+			return nil
+		}
+
+		return fmt.Errorf("the role %q is not permitted by license %s (%s), valid roles are: %v", role, l.ID, l.Name, l.ValidRoles)
+	}
+
+	return fmt.Errorf("license %s not found in the account", licenseID)
+}
+
+// plannedLicenseAllocations keeps track of the license allocations which the
+// users planned for creation would consume, so that the available allocations
+// can be checked across all of the users of a plan rather than one at a time.
+type plannedLicenseAllocations struct {
+	mu       sync.Mutex
+	licenses []*license
+	loaded   bool
+	users    map[string]map[string]bool
+}
+
+// plan records that the user identified by key would consume one of the given
+// licenses, and returns an error describing the overage if the licenses don't
+// have enough allocations available for every planned user.
+func (p *plannedLicenseAllocations) plan(client *pagerduty.Client, key, licenseID, role string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.loaded {
+		licenses, err := listLicenses(client)
+		if err != nil {
+			return err
+		}
+		p.licenses = licenses
+		p.users = make(map[string]map[string]bool)
+		p.loaded = true
+	}
+
+	return p.allocate(key, licenseID, role)
+}
+
+// allocate records the planned allocation of plan once the licenses of the
+// account are loaded. Users planned with a license which isn't in the account,
+// or with a role no license permits, aren't counted.
+func (p *plannedLicenseAllocations) allocate(key, licenseID, role string) error {
+	// Without an explicit license PagerDuty allocates one of the licenses
+	// permitting the role of the user.
+	var candidates []*license
+	for _, l := range p.licenses {
+		if (licenseID != "" && l.ID == licenseID) || (licenseID == "" && l.permitsRole(role)) {
+			candidates = append(candidates, l)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	available := 0
+	var names []string
+	for _, l := range candidates {
+		if l.AllocationsAvailable == nil {
+			// At least one of the licenses is unlimited
+			return nil
+		}
+		available += *l.AllocationsAvailable
+		names = append(names, fmt.Sprintf("%s (%s)", l.Name, l.ID))
+	}
+
+	group := fmt.Sprintf("%v", names)
+	if p.users[group] == nil {
+		p.users[group] = make(map[string]bool)
+	}
+	p.users[group][key] = true
+
+	if planned := len(p.users[group]); planned > available {
+		return fmt.Errorf("%d new users would need an allocation of license %v, but only %d are available", planned, names, available)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package pagerduty
+
+import (
+	"testing"
+)
+
+func TestPlannedLicenseAllocationsAllocate(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	licenses := []*license{
+		{ID: "FULL", Name: "Full User", ValidRoles: []string{"user", "admin"}, AllocationsAvailable: intPtr(2)},
+		{ID: "STAKE", Name: "Stakeholder", ValidRoles: []string{"read_only_user"}, AllocationsAvailable: intPtr(0)},
+		{ID: "UNLIMITED", Name: "Business", ValidRoles: []string{"observer"}},
+		{ID: "LIMITED", Name: "Business Limited", ValidRoles: []string{"observer"}, AllocationsAvailable: intPtr(0)},
+	}
+
+	type user struct {
+		key, licenseID, role string
+	}
+
+	cases := []struct {
+		name  string
+		users []user
+		fails []bool
+	}{
+		{
+			name:  "users sharing a license within its allocations",
+			users: []user{{"a", "FULL", "user"}, {"b", "FULL", "admin"}},
+			fails: []bool{false, false},
+		},
+		{
+			name:  "users sharing a license beyond its allocations",
+			users: []user{{"a", "FULL", "user"}, {"b", "FULL", "user"}, {"c", "FULL", "user"}},
+			fails: []bool{false, false, true},
+		},
+		{
+			name:  "the same user planned twice",
+			users: []user{{"a", "FULL", "user"}, {"a", "FULL", "user"}, {"b", "FULL", "user"}},
+			fails: []bool{false, false, false},
+		},
+		{
+			name:  "license picked by role",
+			users: []user{{"a", "", "user"}, {"b", "", "admin"}, {"c", "", "user"}},
+			fails: []bool{false, false, true},
+		},
+		{
+			name:  "no allocation available",
+			users: []user{{"a", "STAKE", "read_only_user"}},
+			fails: []bool{true},
+		},
+		{
+			name:  "unlimited license among the candidates",
+			users: []user{{"a", "", "observer"}, {"b", "", "observer"}, {"c", "UNLIMITED", "observer"}},
+			fails: []bool{false, false, false},
+		},
+		{
+			name:  "unknown license",
+			users: []user{{"a", "UNKNOWN", "user"}, {"b", "UNKNOWN", "user"}, {"c", "UNKNOWN", "user"}},
+			fails: []bool{false, false, false},
+		},
+		{
+			name:  "role no license permits",
+			users: []user{{"a", "", "owner"}},
+			fails: []bool{false},
+		},
+	}
+
+	for _, c := range cases {
+		p := &plannedLicenseAllocations{
+			licenses: licenses,
+			loaded:   true,
+			users:    make(map[string]map[string]bool),
+		}
+
+		for i, u := range c.users {
+			err := p.allocate(u.key, u.licenseID, u.role)
+			if (err != nil) != c.fails[i] {
+				t.Errorf("%s: user %d (%s): expected failure %t, got %v", c.name, i, u.key, c.fails[i], err)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// onCall represents an on-call entry as returned by the /oncalls endpoint.
+type onCall struct {
+	EscalationLevel  int                                  `json:"escalation_level,omitempty"`
+	EscalationPolicy *pagerduty.EscalationPolicyReference `json:"escalation_policy,omitempty"`
+	Schedule         *pagerduty.ScheduleReference         `json:"schedule,omitempty"`
+	User             *pagerduty.UserReference             `json:"user,omitempty"`
+	Start            string                               `json:"start,omitempty"`
+	End              string                               `json:"end,omitempty"`
+}
+
+type listOnCallsResponse struct {
+	OnCalls []*onCall `json:"oncalls,omitempty"`
+}
+
+// listOnCalls lists all of the on-call entries matching the given query,
+// following pagination until every page has been read.
+func listOnCalls(client *pagerduty.Client, query url.Values) ([]*onCall, error) {
+	var onCalls []*onCall
+	err := listPages(client, "/oncalls", query, func(raw json.RawMessage) (int, bool, error) {
+		resp := new(listOnCallsResponse)
+		if err := json.Unmarshal(raw, resp); err != nil {
+			return 0, false, err
+		}
+
+		onCalls = append(onCalls, resp.OnCalls...)
+		return len(resp.OnCalls), true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return onCalls, nil
+}
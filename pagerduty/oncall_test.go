@@ -0,0 +1,45 @@
+package pagerduty
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestListOnCalls(t *testing.T) {
+	config := testStubbedConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("limit") != "100" || q.Get("schedule_ids[]") != "PSCHED" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+
+		switch q.Get("offset") {
+		case "":
+			w.Write([]byte(`{"oncalls":[{"user":{"id":"PUSER1"}},{"user":{"id":"PUSER2"}}],"more":true}`))
+		case "2":
+			w.Write([]byte(`{"oncalls":[{"user":{"id":"PUSER3"}}],"more":false}`))
+		default:
+			t.Errorf("unexpected offset %q", q.Get("offset"))
+		}
+	})
+
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := url.Values{}
+	query.Add("schedule_ids[]", "PSCHED")
+
+	onCalls, err := listOnCalls(client, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(onCalls) != 3 || onCalls[2].User.ID != "PUSER3" {
+		t.Errorf("expected the on-calls of both pages, got %d", len(onCalls))
+	}
+	if len(query) != 1 {
+		t.Errorf("expected the query of the caller to be left untouched, got %s", query.Encode())
+	}
+}
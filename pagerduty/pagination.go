@@ -0,0 +1,76 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// maxOffsetPaginationRecords is the number of records past which the API
+// rejects requests using classic offset pagination.
+const maxOffsetPaginationRecords = 10000
+
+// listPageInfo holds the pagination fields of list responses, for both
+// classic offset pagination and cursor pagination.
+type listPageInfo struct {
+	More       bool    `json:"more"`
+	NextCursor *string `json:"next_cursor"`
+}
+
+// listPages requests the pages of a list endpoint one at a time and hands
+// each of them to page, which decodes the records it's interested in and
+// returns how many records the page held. Nothing is accumulated here, and
+// page can stop the listing early by returning false.
+//
+// Endpoints returning a next_cursor, such as the audit records, are followed
+// by cursor. The others use offset pagination, which the API caps at 10000
+// records, so listing past that fails with an error asking for a narrower
+// search instead of the API's generic one.
+func listPages(client *pagerduty.Client, path string, query url.Values, page func(raw json.RawMessage) (n int, more bool, err error)) error {
+	// The query is copied so that retrying a listing starts from the first page
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = append([]string(nil), v...)
+	}
+	query = q
+
+	query.Set("limit", "100")
+	offset := 0
+	for {
+		var raw json.RawMessage
+		if _, err := apiRequest(client, "GET", path, query, nil, &raw); err != nil {
+			return err
+		}
+
+		info := new(listPageInfo)
+		if err := json.Unmarshal(raw, info); err != nil {
+			return err
+		}
+
+		n, more, err := page(raw)
+		if err != nil || !more {
+			return err
+		}
+
+		if info.NextCursor != nil {
+			if *info.NextCursor == "" {
+				return nil
+			}
+			query.Set("cursor", *info.NextCursor)
+			continue
+		}
+
+		if !info.More || n == 0 {
+			return nil
+		}
+
+		offset += n
+		if offset >= maxOffsetPaginationRecords {
+			return fmt.Errorf("more than %d records match the search of %s, which is as many as the API can list: narrow down the search", maxOffsetPaginationRecords, path)
+		}
+		query.Set("offset", strconv.Itoa(offset))
+	}
+}
@@ -0,0 +1,54 @@
+package pagerduty
+
+import "sync"
+
+// defaultMaxParallelRequests is the number of requests made at once when
+// max_parallel_requests isn't set on the provider.
+const defaultMaxParallelRequests = 4
+
+// requestSlots returns the semaphore shared by every parallel read of the
+// provider, so that the reads of several data sources refreshed at once
+// together stay within max_parallel_requests.
+func (c *Config) requestSlots() chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.slots == nil {
+		n := c.MaxParallelRequests
+		if n < 1 {
+			n = defaultMaxParallelRequests
+		}
+		c.slots = make(chan struct{}, n)
+	}
+	return c.slots
+}
+
+// forEachParallel calls fn for each index up to n, running as many calls at
+// once as the provider allows. It waits for every call to return and returns
+// the error of the lowest index which failed, so that the outcome doesn't
+// depend on scheduling.
+func forEachParallel(c *Config, n int, fn func(i int) error) error {
+	slots := c.requestSlots()
+	errs := make([]error, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package pagerduty
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+func TestForEachParallel(t *testing.T) {
+	config := &Config{MaxParallelRequests: 3}
+
+	var running, peak int32
+	seen := make([]bool, 50)
+	err := forEachParallel(config, len(seen), func(i int) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		seen[i] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if peak > 3 {
+		t.Errorf("expected at most 3 calls at once, got %d", peak)
+	}
+	for i, ok := range seen {
+		if !ok {
+			t.Errorf("expected fn to be called for index %d", i)
+		}
+	}
+}
+
+func TestForEachParallel_Error(t *testing.T) {
+	config := &Config{}
+
+	err := forEachParallel(config, 10, func(i int) error {
+		if i == 4 || i == 7 {
+			return fmt.Errorf("failed %d", i)
+		}
+		return nil
+	})
+	if err == nil || err.Error() != "failed 4" {
+		t.Errorf("expected the error of the lowest index, got %v", err)
+	}
+}
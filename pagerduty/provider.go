@@ -1,15 +1,24 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"runtime"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
+// resourceWarnings maps resource types to the checks run after they are
+// created or updated, whose warnings are shown by Terraform.
+var resourceWarnings = map[string]func(*schema.ResourceData, interface{}) diag.Diagnostics{
+	"pagerduty_escalation_policy": escalationPolicyWarnings,
+}
+
 // Provider represents a resource provider in Terraform
 func Provider() *schema.Provider {
 	p := &schema.Provider{
@@ -43,58 +52,142 @@ func Provider() *schema.Provider {
 				Optional: true,
 				Default:  "",
 			},
+
+			"validate_escalation_targets": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"check_references": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"check_dependency_cycles": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"max_parallel_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMaxParallelRequests,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+
+			"license_overage_check": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "off",
+				ValidateFunc: validateValueFunc([]string{
+					"off",
+					"error",
+				}),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"pagerduty_escalation_policy":   dataSourcePagerDutyEscalationPolicy(),
-			"pagerduty_schedule":            dataSourcePagerDutySchedule(),
-			"pagerduty_user":                dataSourcePagerDutyUser(),
-			"pagerduty_user_contact_method": dataSourcePagerDutyUserContactMethod(),
-			"pagerduty_team":                dataSourcePagerDutyTeam(),
-			"pagerduty_vendor":              dataSourcePagerDutyVendor(),
-			"pagerduty_extension_schema":    dataSourcePagerDutyExtensionSchema(),
-			"pagerduty_service":             dataSourcePagerDutyService(),
-			"pagerduty_service_integration": dataSourcePagerDutyServiceIntegration(),
-			"pagerduty_business_service":    dataSourcePagerDutyBusinessService(),
-			"pagerduty_priority":            dataSourcePagerDutyPriority(),
-			"pagerduty_ruleset":             dataSourcePagerDutyRuleset(),
-			"pagerduty_tag":                 dataSourcePagerDutyTag(),
-			"pagerduty_event_orchestration": dataSourcePagerDutyEventOrchestration(),
+			"pagerduty_escalation_policy":             dataSourcePagerDutyEscalationPolicy(),
+			"pagerduty_schedule":                      dataSourcePagerDutySchedule(),
+			"pagerduty_current_user":                  dataSourcePagerDutyCurrentUser(),
+			"pagerduty_user":                          dataSourcePagerDutyUser(),
+			"pagerduty_users":                         dataSourcePagerDutyUsers(),
+			"pagerduty_user_contact_method":           dataSourcePagerDutyUserContactMethod(),
+			"pagerduty_team":                          dataSourcePagerDutyTeam(),
+			"pagerduty_teams":                         dataSourcePagerDutyTeams(),
+			"pagerduty_team_members":                  dataSourcePagerDutyTeamMembers(),
+			"pagerduty_licenses":                      dataSourcePagerDutyLicenses(),
+			"pagerduty_vendor":                        dataSourcePagerDutyVendor(),
+			"pagerduty_vendors":                       dataSourcePagerDutyVendors(),
+			"pagerduty_extension_schema":              dataSourcePagerDutyExtensionSchema(),
+			"pagerduty_extensions":                    dataSourcePagerDutyExtensions(),
+			"pagerduty_incident_analytics":            dataSourcePagerDutyIncidentAnalytics(),
+			"pagerduty_incidents":                     dataSourcePagerDutyIncidents(),
+			"pagerduty_oncalls":                       dataSourcePagerDutyOnCalls(),
+			"pagerduty_maintenance_windows":           dataSourcePagerDutyMaintenanceWindows(),
+			"pagerduty_paused_incident_report":        dataSourcePagerDutyPausedIncidentReport(),
+			"pagerduty_service":                       dataSourcePagerDutyService(),
+			"pagerduty_service_integration":           dataSourcePagerDutyServiceIntegration(),
+			"pagerduty_business_service":              dataSourcePagerDutyBusinessService(),
+			"pagerduty_business_services":             dataSourcePagerDutyBusinessServices(),
+			"pagerduty_business_service_dependencies": dataSourcePagerDutyBusinessServiceDependencies(),
+			"pagerduty_abilities":                     dataSourcePagerDutyAbilities(),
+			"pagerduty_audit_records":                 dataSourcePagerDutyAuditRecords(),
+			"pagerduty_priority":                      dataSourcePagerDutyPriority(),
+			"pagerduty_response_play":                 dataSourcePagerDutyResponsePlay(),
+			"pagerduty_responder_analytics":           dataSourcePagerDutyResponderAnalytics(),
+			"pagerduty_standards":                     dataSourcePagerDutyStandards(),
+			"pagerduty_standards_resource_scores":     dataSourcePagerDutyStandardsResourceScores(),
+			"pagerduty_standards_resources_scores":    dataSourcePagerDutyStandardsResourcesScores(),
+			"pagerduty_ruleset":                       dataSourcePagerDutyRuleset(),
+			"pagerduty_ruleset_rules":                 dataSourcePagerDutyRulesetRules(),
+			"pagerduty_tag":                           dataSourcePagerDutyTag(),
+			"pagerduty_tags":                          dataSourcePagerDutyTags(),
+			"pagerduty_event_orchestration":           dataSourcePagerDutyEventOrchestration(),
+			"pagerduty_jira_cloud_account_mapping":    dataSourcePagerDutyJiraCloudAccountMapping(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"pagerduty_addon":                        resourcePagerDutyAddon(),
-			"pagerduty_escalation_policy":            resourcePagerDutyEscalationPolicy(),
-			"pagerduty_maintenance_window":           resourcePagerDutyMaintenanceWindow(),
-			"pagerduty_schedule":                     resourcePagerDutySchedule(),
-			"pagerduty_service":                      resourcePagerDutyService(),
-			"pagerduty_service_integration":          resourcePagerDutyServiceIntegration(),
-			"pagerduty_team":                         resourcePagerDutyTeam(),
-			"pagerduty_team_membership":              resourcePagerDutyTeamMembership(),
-			"pagerduty_user":                         resourcePagerDutyUser(),
-			"pagerduty_user_contact_method":          resourcePagerDutyUserContactMethod(),
-			"pagerduty_user_notification_rule":       resourcePagerDutyUserNotificationRule(),
-			"pagerduty_extension":                    resourcePagerDutyExtension(),
-			"pagerduty_extension_servicenow":         resourcePagerDutyExtensionServiceNow(),
-			"pagerduty_event_rule":                   resourcePagerDutyEventRule(),
-			"pagerduty_ruleset":                      resourcePagerDutyRuleset(),
-			"pagerduty_ruleset_rule":                 resourcePagerDutyRulesetRule(),
-			"pagerduty_business_service":             resourcePagerDutyBusinessService(),
-			"pagerduty_service_dependency":           resourcePagerDutyServiceDependency(),
-			"pagerduty_response_play":                resourcePagerDutyResponsePlay(),
-			"pagerduty_tag":                          resourcePagerDutyTag(),
-			"pagerduty_tag_assignment":               resourcePagerDutyTagAssignment(),
-			"pagerduty_service_event_rule":           resourcePagerDutyServiceEventRule(),
-			"pagerduty_slack_connection":             resourcePagerDutySlackConnection(),
-			"pagerduty_business_service_subscriber":  resourcePagerDutyBusinessServiceSubscriber(),
-			"pagerduty_webhook_subscription":         resourcePagerDutyWebhookSubscription(),
-			"pagerduty_event_orchestration":          resourcePagerDutyEventOrchestration(),
-			"pagerduty_event_orchestration_router":   resourcePagerDutyEventOrchestrationPathRouter(),
-			"pagerduty_event_orchestration_unrouted": resourcePagerDutyEventOrchestrationPathUnrouted(),
-			"pagerduty_event_orchestration_service":  resourcePagerDutyEventOrchestrationPathService(),
+			"pagerduty_addon":                           resourcePagerDutyAddon(),
+			"pagerduty_custom_event_transformer":        resourcePagerDutyCustomEventTransformer(),
+			"pagerduty_escalation_policy":               resourcePagerDutyEscalationPolicy(),
+			"pagerduty_escalation_rule":                 resourcePagerDutyEscalationRule(),
+			"pagerduty_maintenance_window":              resourcePagerDutyMaintenanceWindow(),
+			"pagerduty_msteams_connection":              resourcePagerDutyMSTeamsConnection(),
+			"pagerduty_schedule":                        resourcePagerDutySchedule(),
+			"pagerduty_service":                         resourcePagerDutyService(),
+			"pagerduty_service_integration":             resourcePagerDutyServiceIntegration(),
+			"pagerduty_team":                            resourcePagerDutyTeam(),
+			"pagerduty_team_membership":                 resourcePagerDutyTeamMembership(),
+			"pagerduty_team_memberships":                resourcePagerDutyTeamMemberships(),
+			"pagerduty_user":                            resourcePagerDutyUser(),
+			"pagerduty_user_contact_method":             resourcePagerDutyUserContactMethod(),
+			"pagerduty_user_notification_rule":          resourcePagerDutyUserNotificationRule(),
+			"pagerduty_user_handoff_notification_rule":  resourcePagerDutyUserHandoffNotificationRule(),
+			"pagerduty_user_notification_rules":         resourcePagerDutyUserNotificationRules(),
+			"pagerduty_extension":                       resourcePagerDutyExtension(),
+			"pagerduty_extension_salesforce":            resourcePagerDutyExtensionSalesforce(),
+			"pagerduty_extension_servicenow":            resourcePagerDutyExtensionServiceNow(),
+			"pagerduty_extension_zendesk":               resourcePagerDutyExtensionZendesk(),
+			"pagerduty_event_rule":                      resourcePagerDutyEventRule(),
+			"pagerduty_ruleset":                         resourcePagerDutyRuleset(),
+			"pagerduty_ruleset_rule":                    resourcePagerDutyRulesetRule(),
+			"pagerduty_business_service":                resourcePagerDutyBusinessService(),
+			"pagerduty_service_dependency":              resourcePagerDutyServiceDependency(),
+			"pagerduty_response_play":                   resourcePagerDutyResponsePlay(),
+			"pagerduty_tag":                             resourcePagerDutyTag(),
+			"pagerduty_tag_assignment":                  resourcePagerDutyTagAssignment(),
+			"pagerduty_tag_assignments":                 resourcePagerDutyTagAssignments(),
+			"pagerduty_service_event_rule":              resourcePagerDutyServiceEventRule(),
+			"pagerduty_slack_connection":                resourcePagerDutySlackConnection(),
+			"pagerduty_standard":                        resourcePagerDutyStandard(),
+			"pagerduty_business_service_subscriber":     resourcePagerDutyBusinessServiceSubscriber(),
+			"pagerduty_webhook_subscription":            resourcePagerDutyWebhookSubscription(),
+			"pagerduty_event_orchestration":             resourcePagerDutyEventOrchestration(),
+			"pagerduty_jira_cloud_account_mapping_rule": resourcePagerDutyJiraCloudAccountMappingRule(),
+			"pagerduty_event_orchestration_router":      resourcePagerDutyEventOrchestrationPathRouter(),
+			"pagerduty_event_orchestration_unrouted":    resourcePagerDutyEventOrchestrationPathUnrouted(),
+			"pagerduty_event_orchestration_service":     resourcePagerDutyEventOrchestrationPathService(),
+			"pagerduty_incident":                        resourcePagerDutyIncident(),
 		},
 	}
 
+	for name, r := range p.ResourcesMap {
+		failFastWhenUnavailable(r)
+		describeAPIErrors(name, r)
+		warnWhenGone(name, r)
+		if warn, ok := resourceWarnings[name]; ok {
+			reportWarnings(r, warn)
+		}
+	}
+	for name, r := range p.DataSourcesMap {
+		failFastWhenUnavailable(r)
+		describeAPIErrors(name, r)
+	}
+
 	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
@@ -117,18 +210,62 @@ func isErrCode(err error, code int) bool {
 }
 
 func genError(err error, d *schema.ResourceData) error {
-	return fmt.Errorf("Error reading: %s: %s", d.Id(), err)
+	return fmt.Errorf("Error reading: %s: %w", d.Id(), err)
 }
 
+// handleNotFoundError removes the resource from the state when err is a 404,
+// which warnWhenGone reports, and wraps other errors. Creates read the new
+// object with genError instead, so that a 404 caused by eventual consistency
+// is retried rather than dropping the object from the state.
 func handleNotFoundError(err error, d *schema.ResourceData) error {
 	if isErrCode(err, 404) {
-		log.Printf("[WARN] Removing %s because it's gone", d.Id())
 		d.SetId("")
 		return nil
 	}
 	return genError(err, d)
 }
 
+// warnWhenGone logs a warning naming the resource type and ID when a Read
+// removes a resource from the state, because it was deleted outside of
+// Terraform.
+func warnWhenGone(name string, r *schema.Resource) {
+	read := r.Read
+	if read == nil {
+		return
+	}
+
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		id := d.Id()
+		err := read(d, meta)
+		if err == nil && id != "" && d.Id() == "" {
+			log.Printf("[WARN] Removing %s %s from the state because it no longer exists in PagerDuty", name, id)
+		}
+		return err
+	}
+}
+
+// reportWarnings replaces the Create and Update of a resource with their
+// context-aware variants, which are the only ones able to return warnings,
+// and returns the warnings of warn once they succeed.
+func reportWarnings(r *schema.Resource, warn func(*schema.ResourceData, interface{}) diag.Diagnostics) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := f(d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			if d.Id() == "" {
+				return nil
+			}
+			return warn(d, meta)
+		}
+	}
+
+	r.CreateContext, r.Create = wrap(r.Create), nil
+	if r.Update != nil {
+		r.UpdateContext, r.Update = wrap(r.Update), nil
+	}
+}
+
 func providerConfigure(data *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	var ServiceRegion = strings.ToLower(data.Get("service_region").(string))
 
@@ -146,6 +283,12 @@ func providerConfigure(data *schema.ResourceData, terraformVersion string) (inte
 		UserToken:           data.Get("user_token").(string),
 		UserAgent:           fmt.Sprintf("(%s %s) Terraform/%s", runtime.GOOS, runtime.GOARCH, terraformVersion),
 		ApiUrlOverride:      data.Get("api_url_override").(string),
+
+		ValidateEscalationTargets: data.Get("validate_escalation_targets").(bool),
+		LicenseOverageCheck:       data.Get("license_overage_check").(string),
+		CheckReferences:           data.Get("check_references").(bool),
+		CheckDependencyCycles:     data.Get("check_dependency_cycles").(bool),
+		MaxParallelRequests:       data.Get("max_parallel_requests").(int),
 	}
 
 	log.Println("[INFO] Initializing PagerDuty client")
@@ -1,9 +1,13 @@
 package pagerduty
 
 import (
+	"bytes"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,6 +35,52 @@ func TestProviderImpl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestWarnWhenGone(t *testing.T) {
+	notFound := &pagerduty.Error{
+		ErrorResponse: &pagerduty.Response{
+			Response: &http.Response{Status: "404 Not Found", StatusCode: 404},
+		},
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return handleNotFoundError(notFound, d)
+		},
+	}
+	warnWhenGone("pagerduty_team", r)
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	d := r.TestResourceData()
+	d.SetId("PFOO")
+	if err := r.Read(d, nil); err != nil {
+		t.Fatalf("expected a 404 not to fail the read, got %s", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected the resource to be removed from the state, got ID %q", d.Id())
+	}
+	if !strings.Contains(buf.String(), "[WARN] Removing pagerduty_team PFOO from the state") {
+		t.Errorf("expected a warning naming the resource type and ID, got %q", buf.String())
+	}
+}
+
+// testStubbedConfig returns a configuration whose client sends its requests to
+// handler instead of the PagerDuty API.
+func testStubbedConfig(t *testing.T, handler http.HandlerFunc) *Config {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Config{
+		ApiUrl:              server.URL,
+		Token:               "foo",
+		SkipCredsValidation: true,
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("PAGERDUTY_PARALLEL"); v != "" {
 		t.Parallel()
@@ -0,0 +1,115 @@
+package pagerduty
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimitTransport paces the requests made through it to the rate limit
+// reported by the API, so that the parallel requests of a large apply are
+// spread out instead of bursting into 429s. It's a token bucket shared by
+// every goroutine using the client, whose rate is adjusted after each
+// response to the requests remaining in the current window over the time
+// left until the window resets. Until the API has reported its limits,
+// requests aren't delayed.
+type rateLimitTransport struct {
+	next http.RoundTripper
+
+	mu     sync.Mutex
+	rate   float64 // tokens per second, 0 until the limits are known
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	// now and sleep are replaced in tests
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func newRateLimitTransport(next http.RoundTripper) *rateLimitTransport {
+	return &rateLimitTransport{
+		next:  next,
+		now:   time.Now,
+		sleep: time.Sleep,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if d := t.reserve(); d > 0 {
+		log.Printf("[DEBUG] Delaying request to %s by %s to stay within the PagerDuty rate limit", req.URL.Path, d)
+		t.sleep(d)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	t.update(resp)
+	return resp, nil
+}
+
+// reserve takes a token from the bucket and returns how long to wait for it.
+// Tokens are taken ahead of time, so that concurrent requests queue up one
+// interval apart.
+func (t *rateLimitTransport) reserve() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.rate == 0 {
+		return 0
+	}
+
+	now := t.now()
+	t.tokens = math.Min(t.burst, t.tokens+now.Sub(t.last).Seconds()*t.rate)
+	t.last = now
+	t.tokens--
+
+	if t.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-t.tokens / t.rate * float64(time.Second))
+}
+
+// update adjusts the bucket to the ratelimit-limit, ratelimit-remaining and
+// ratelimit-reset headers of the response. Responses without them, such as
+// those of endpoints which aren't rate limited this way, leave it unchanged.
+func (t *rateLimitTransport) update(resp *http.Response) {
+	limit, err1 := strconv.Atoi(resp.Header.Get("ratelimit-limit"))
+	remaining, err2 := strconv.Atoi(resp.Header.Get("ratelimit-remaining"))
+	reset, err3 := strconv.Atoi(resp.Header.Get("ratelimit-reset"))
+	if err1 != nil || err2 != nil || err3 != nil || limit <= 0 {
+		return
+	}
+	if reset < 1 {
+		reset = 1
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		remaining = 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.rate == 0 {
+		// The bucket starts full once the limits are known
+		t.last = t.now()
+		t.tokens = float64(limit)
+	}
+
+	// The remaining requests are spread over the rest of the window, with
+	// bursts of up to a tenth of the limit.
+	t.burst = math.Max(float64(limit)/10, 1)
+	if remaining > 0 {
+		t.rate = float64(remaining) / float64(reset)
+		t.tokens = math.Min(t.tokens, math.Min(float64(remaining), t.burst))
+	} else {
+		// Nothing is left, so the next request waits for the window to reset
+		t.rate = 1 / float64(reset)
+		t.tokens = math.Min(t.tokens, 0)
+	}
+}
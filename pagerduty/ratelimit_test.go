@@ -0,0 +1,77 @@
+package pagerduty
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var slept []time.Duration
+
+	remaining := "20"
+	tr := newRateLimitTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		h := http.Header{}
+		h.Set("ratelimit-limit", "100")
+		h.Set("ratelimit-remaining", remaining)
+		h.Set("ratelimit-reset", "10")
+		return &http.Response{StatusCode: 200, Header: h}, nil
+	}))
+	tr.now = func() time.Time { return now }
+	tr.sleep = func(d time.Duration) { slept = append(slept, d) }
+
+	req, _ := http.NewRequest("GET", "https://api.pagerduty.com/users", nil)
+
+	// The first request isn't delayed, as the limits aren't known yet
+	if _, err := tr.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	if len(slept) != 0 {
+		t.Fatalf("expected the first request not to be delayed, got %v", slept)
+	}
+
+	// 20 requests remain over 10 seconds, so after the burst of 10 the
+	// requests are spaced half a second apart.
+	for i := 0; i < 12; i++ {
+		if _, err := tr.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(slept) != 2 {
+		t.Fatalf("expected 2 delayed requests, got %v", slept)
+	}
+	if slept[0] != 500*time.Millisecond || slept[1] != time.Second {
+		t.Errorf("expected the requests to be delayed by 0.5s and 1s, got %v", slept)
+	}
+
+	// Once nothing remains, the next request waits for the window to reset
+	remaining = "0"
+	now = now.Add(time.Minute)
+	slept = nil
+	tr.RoundTrip(req)
+	tr.RoundTrip(req)
+	if len(slept) != 1 || slept[0] != 10*time.Second {
+		t.Errorf("expected the request to wait 10s for the reset, got %v", slept)
+	}
+}
+
+func TestRateLimitTransport_NoHeaders(t *testing.T) {
+	tr := newRateLimitTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 200, Header: http.Header{}}, nil
+	}))
+	tr.sleep = func(d time.Duration) { t.Errorf("expected no delay, got %s", d) }
+
+	req, _ := http.NewRequest("GET", "https://api.pagerduty.com/users", nil)
+	for i := 0; i < 100; i++ {
+		if _, err := tr.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// referencePaths maps the kinds of objects which can be referenced by ID to
+// the API path they are read from.
+var referencePaths = map[string]string{
+	"escalation_policy": "/escalation_policies/",
+	"schedule":          "/schedules/",
+	"team":              "/teams/",
+	"user":              "/users/",
+}
+
+// referenceAttribute is an attribute holding the IDs of objects of a given
+// kind. A * in the key matches every element of a list.
+type referenceAttribute struct {
+	key  string
+	kind string
+}
+
+// checkedReferences caches the outcome of checking that referenced objects
+// exist, so that an object referenced by many resources of a plan is only
+// read once.
+type checkedReferences struct {
+	mu         sync.Mutex
+	checked    map[string]error
+	priorities map[string]bool
+}
+
+// check returns an error if the object of the given kind and ID does not
+// exist in the account.
+func (c *checkedReferences) check(client *pagerduty.Client, kind, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := kind + "/" + id
+	if err, ok := c.checked[key]; ok {
+		return err
+	}
+
+	exists, err := c.exists(client, kind, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		err = fmt.Errorf("%s %s does not exist", strings.Replace(kind, "_", " ", -1), id)
+	}
+
+	if c.checked == nil {
+		c.checked = make(map[string]error)
+	}
+	c.checked[key] = err
+
+	return err
+}
+
+func (c *checkedReferences) exists(client *pagerduty.Client, kind, id string) (bool, error) {
+	exists := true
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		if kind == "priority" {
+			err = c.loadPriorities(client)
+			exists = c.priorities[id]
+		} else {
+			_, err = apiRequest(client, "GET", referencePaths[kind]+id, nil, nil, nil)
+		}
+
+		if err != nil {
+			if isErrCode(err, 404) {
+				exists = false
+				return nil
+			}
+			if isErrCode(err, 429) {
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	return exists, err
+}
+
+// loadPriorities lists the priorities of the account once, as they can't be
+// read one at a time.
+func (c *checkedReferences) loadPriorities(client *pagerduty.Client) error {
+	if c.priorities != nil {
+		return nil
+	}
+
+	resp, _, err := client.Priorities.List()
+	if err != nil {
+		return err
+	}
+
+	c.priorities = make(map[string]bool)
+	for _, p := range resp.Priorities {
+		c.priorities[p.ID] = true
+	}
+	return nil
+}
+
+// validateReferences checks at plan time that the objects referenced by ID
+// from the given attributes exist, so that a typo fails the plan with the
+// offending attribute instead of failing the apply with a generic 400. It
+// only runs when check_references is enabled on the provider, and only for
+// the attributes changed by the plan.
+func validateReferences(attrs ...referenceAttribute) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		config, ok := meta.(*Config)
+		if !ok || !config.CheckReferences {
+			return nil
+		}
+
+		client, err := config.Client()
+		if err != nil {
+			return err
+		}
+
+		for _, attr := range attrs {
+			for _, key := range expandReferenceKey(diff, attr.key) {
+				if !diff.HasChange(key) || !diff.NewValueKnown(key) {
+					continue
+				}
+
+				var ids []interface{}
+				switch v := diff.Get(key).(type) {
+				case string:
+					ids = []interface{}{v}
+				case []interface{}:
+					ids = v
+				case *schema.Set:
+					ids = v.List()
+				}
+
+				for _, v := range ids {
+					id, _ := v.(string)
+					if id == "" {
+						continue
+					}
+					if err := config.references.check(client, attr.kind, id); err != nil {
+						return fmt.Errorf("%s: %s", key, err)
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// expandReferenceKey expands every * in the key to the indexes of the list it
+// refers to, e.g. layer.*.users to layer.0.users and layer.1.users.
+func expandReferenceKey(diff *schema.ResourceDiff, key string) []string {
+	i := strings.Index(key, "*")
+	if i < 0 {
+		return []string{key}
+	}
+
+	prefix := key[:i]
+	n := diff.Get(prefix + "#").(int)
+
+	var keys []string
+	for j := 0; j < n; j++ {
+		keys = append(keys, expandReferenceKey(diff, fmt.Sprintf("%s%d%s", prefix, j, key[i+1:]))...)
+	}
+	return keys
+}
@@ -1,6 +1,8 @@
 package pagerduty
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -9,12 +11,24 @@ import (
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
+// addon extends pagerduty.Addon with the services an incident_show_addon is
+// restricted to.
+type addon struct {
+	pagerduty.Addon
+	Services []*pagerduty.ServiceReference `json:"services,omitempty"`
+}
+
+type addonPayload struct {
+	Addon *addon `json:"addon"`
+}
+
 func resourcePagerDutyAddon() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyAddonCreate,
-		Read:   resourcePagerDutyAddonRead,
-		Update: resourcePagerDutyAddonUpdate,
-		Delete: resourcePagerDutyAddonDelete,
+		Create:        resourcePagerDutyAddonCreate,
+		Read:          resourcePagerDutyAddonRead,
+		Update:        resourcePagerDutyAddonUpdate,
+		Delete:        resourcePagerDutyAddonDelete,
+		CustomizeDiff: validateAddonServices,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -27,20 +41,70 @@ func resourcePagerDutyAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "full_page_addon",
+				ValidateFunc: validateValueFunc([]string{
+					"full_page_addon",
+					"incident_show_addon",
+				}),
+			},
+			"services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func buildAddonStruct(d *schema.ResourceData) *pagerduty.Addon {
-	addon := &pagerduty.Addon{
-		Name: d.Get("name").(string),
-		Src:  d.Get("src").(string),
-		Type: "full_page_addon",
+func validateAddonServices(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("type") {
+		return nil
+	}
+
+	if t := diff.Get("type").(string); t != "incident_show_addon" && diff.Get("services").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("`services` can only be set when `type` is `incident_show_addon`, got `%s`", t)
+	}
+
+	return nil
+}
+
+func buildAddonStruct(d *schema.ResourceData) *addon {
+	addon := &addon{
+		Addon: pagerduty.Addon{
+			Name: d.Get("name").(string),
+			Src:  d.Get("src").(string),
+			Type: d.Get("type").(string),
+		},
+	}
+
+	if addon.Type == "incident_show_addon" {
+		// An empty list makes the add-on available on all services.
+		addon.Services = []*pagerduty.ServiceReference{}
+		if attr, ok := d.GetOk("services"); ok {
+			addon.Services = expandServiceObjects(attr)
+		}
 	}
 
 	return addon
 }
 
+func flattenAddonServices(services []*pagerduty.ServiceReference) []interface{} {
+	var result []interface{}
+	for _, s := range services {
+		result = append(result, s.ID)
+	}
+
+	return result
+}
+
 func fetchPagerDutyAddon(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -48,8 +112,8 @@ func fetchPagerDutyAddon(d *schema.ResourceData, meta interface{}, errCallback f
 	}
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		addon, _, err := client.Addons.Get(d.Id())
-		if err != nil {
+		v := new(addonPayload)
+		if _, err := apiRequest(client, "GET", "/addons/"+d.Id(), nil, nil, v); err != nil {
 			log.Printf("[WARN] Service read error")
 			errResp := errCallback(err, d)
 			if errResp != nil {
@@ -60,8 +124,14 @@ func fetchPagerDutyAddon(d *schema.ResourceData, meta interface{}, errCallback f
 			return nil
 		}
 
+		addon := v.Addon
 		d.Set("name", addon.Name)
 		d.Set("src", addon.Src)
+		d.Set("type", addon.Type)
+		d.Set("html_url", addon.HTMLURL)
+		if err := d.Set("services", flattenAddonServices(addon.Services)); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
 		return nil
 	})
@@ -77,12 +147,12 @@ func resourcePagerDutyAddonCreate(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Creating PagerDuty add-on %s", addon.Name)
 
-	addon, _, err = client.Addons.Install(addon)
-	if err != nil {
+	v := new(addonPayload)
+	if _, err := apiRequest(client, "POST", "/addons", nil, &addonPayload{Addon: addon}, v); err != nil {
 		return err
 	}
 
-	d.SetId(addon.ID)
+	d.SetId(v.Addon.ID)
 	// Retrying on creates incase of eventual consistency on creation
 	return fetchPagerDutyAddon(d, meta, genError)
 }
@@ -102,7 +172,7 @@ func resourcePagerDutyAddonUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Updating PagerDuty add-on %s", d.Id())
 
-	if _, _, err := client.Addons.Update(d.Id(), addon); err != nil {
+	if _, err := apiRequest(client, "PUT", "/addons/"+d.Id(), nil, &addonPayload{Addon: addon}, nil); err != nil {
 		return err
 	}
 
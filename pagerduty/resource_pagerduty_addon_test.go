@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -80,6 +81,46 @@ func TestAccPagerDutyAddon_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyAddon_IncidentShow(t *testing.T) {
+	addon := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAddonConfigIncidentShow(addon, username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAddonExists("pagerduty_addon.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_addon.foo", "type", "incident_show_addon"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_addon.foo", "services.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyAddon_InvalidServices(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyAddonConfigFullPageServices(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`services` can only be set when `type` is `incident_show_addon`"),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyAddonDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -138,3 +179,48 @@ resource "pagerduty_addon" "foo" {
 }
 `, addon)
 }
+
+func testAccCheckPagerDutyAddonConfigIncidentShow(addon, username, email, escalationPolicy, service string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_addon" "foo" {
+  name     = "%s"
+  src      = "https://intranet.foo.test/incident"
+  type     = "incident_show_addon"
+  services = [pagerduty_service.foo.id]
+}
+`, username, email, escalationPolicy, service, addon)
+}
+
+func testAccCheckPagerDutyAddonConfigFullPageServices() string {
+	return `
+resource "pagerduty_addon" "foo" {
+  name     = "foo"
+  src      = "https://intranet.foo.test/status"
+  services = ["PXXXXXX"]
+}
+`
+}
@@ -117,20 +117,24 @@ func resourcePagerDutyBusinessServiceCreate(d *schema.ResourceData, meta interfa
 		return retryErr
 	}
 
-	return resourcePagerDutyBusinessServiceRead(d, meta)
+	return fetchPagerDutyBusinessService(d, meta, genError)
 }
 
-func resourcePagerDutyBusinessServiceRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutyBusinessService(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Reading PagerDuty business service %s", d.Id())
-
 	retryErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		if businessService, _, err := client.BusinessServices.Get(d.Id()); err != nil {
-			return resource.RetryableError(err)
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if businessService != nil {
 			d.Set("name", businessService.Name)
 			d.Set("html_url", businessService.HTMLUrl)
@@ -154,6 +158,11 @@ func resourcePagerDutyBusinessServiceRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourcePagerDutyBusinessServiceRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty business service %s", d.Id())
+	return fetchPagerDutyBusinessService(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutyBusinessServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -167,6 +176,10 @@ func resourcePagerDutyBusinessServiceUpdate(d *schema.ResourceData, meta interfa
 	log.Printf("[DEBUG] poc: %v", businessService.PointOfContact)
 	log.Printf("[DEBUG] point_of_contact: %v", d.Get("point_of_contact"))
 
+	if err := clearDescription(client, d, "/business_services/"+d.Id(), "business_service"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Updating PagerDuty business service %s", d.Id())
 
 	if _, _, err := client.BusinessServices.Update(d.Id(), businessService); err != nil {
@@ -3,7 +3,6 @@ package pagerduty
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -82,10 +81,10 @@ func resourcePagerDutyBusinessServiceSubscriberCreate(d *schema.ResourceData, me
 		return retryErr
 	}
 
-	return resourcePagerDutyBusinessServiceSubscriberRead(d, meta)
+	return fetchPagerDutyBusinessServiceSubscriber(d, meta, genError)
 }
 
-func resourcePagerDutyBusinessServiceSubscriberRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutyBusinessServiceSubscriber(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
@@ -98,8 +97,13 @@ func resourcePagerDutyBusinessServiceSubscriberRead(d *schema.ResourceData, meta
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		if subscriberResponse, _, err := client.BusinessServiceSubscribers.List(businessServiceId); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if subscriberResponse != nil {
 			var foundSubscriber *pagerduty.BusinessServiceSubscriber
 
@@ -119,6 +123,10 @@ func resourcePagerDutyBusinessServiceSubscriberRead(d *schema.ResourceData, meta
 	})
 }
 
+func resourcePagerDutyBusinessServiceSubscriberRead(d *schema.ResourceData, meta interface{}) error {
+	return fetchPagerDutyBusinessServiceSubscriber(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutyBusinessServiceSubscriberDelete(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -144,14 +152,14 @@ func createSubscriberID(businessServiceId string, subscriberType string, subscri
 }
 
 func resourcePagerDutyBusinessServiceSubscriberImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	ids := strings.Split(d.Id(), ".")
-	client, err := meta.(*Config).Client()
+	ids, err := parseCompositeImportID("pagerduty_business_service_subscriber", d.Id(), "business_service_id", "subscriber_type", "subscriber_id")
 	if err != nil {
 		return []*schema.ResourceData{}, err
 	}
 
-	if len(ids) != 3 {
-		return []*schema.ResourceData{}, fmt.Errorf("error importing pagerduty_business_service_subscriber. Expecting an importation ID formed as '<business_service_id>.<subscriber_type>.<subscriber_id>'")
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 
 	businessServiceId, businessServiceSubscriberType, businessServiceSubscriberID := ids[0], ids[1], ids[2]
@@ -0,0 +1,254 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+const customEventTransformerVendorName = "Custom Event Transformer"
+
+// customEventTransformer is a service integration of the Custom Event
+// Transformer vendor, whose JavaScript code transforms incoming events.
+type customEventTransformer struct {
+	pagerduty.Integration
+	Config *customEventTransformerConfig `json:"config,omitempty"`
+}
+
+type customEventTransformerConfig struct {
+	Code string `json:"code"`
+}
+
+type customEventTransformerPayload struct {
+	Integration *customEventTransformer `json:"integration"`
+}
+
+func customEventTransformerPath(serviceID, id string) string {
+	path := fmt.Sprintf("/services/%s/integrations", serviceID)
+	if id != "" {
+		path = fmt.Sprintf("%s/%s", path, id)
+	}
+	return path
+}
+
+func resourcePagerDutyCustomEventTransformer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyCustomEventTransformerCreate,
+		Read:   resourcePagerDutyCustomEventTransformerRead,
+		Update: resourcePagerDutyCustomEventTransformerUpdate,
+		Delete: resourcePagerDutyCustomEventTransformerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyCustomEventTransformerImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  customEventTransformerVendorName,
+			},
+			"code": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: normalizeTransformerCode,
+			},
+			"vendor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"integration_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// normalizeTransformerCode makes whitespace-only changes to the transform
+// body, like line endings, trailing spaces or surrounding blank lines, not
+// show up as a diff.
+func normalizeTransformerCode(v interface{}) string {
+	lines := strings.Split(strings.ReplaceAll(v.(string), "\r\n", "\n"), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " \t")
+	}
+
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+func findCustomEventTransformerVendor(config *Config, client *pagerduty.Client) (string, error) {
+	vendors, err := config.catalogs.listVendors(client)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, len(vendors))
+	for i, vendor := range vendors {
+		names[i] = vendor.Name
+	}
+
+	i := bestNameMatch(customEventTransformerVendorName, names, true)
+	if i == -1 {
+		return "", fmt.Errorf("Unable to locate the %s vendor", customEventTransformerVendorName)
+	}
+
+	return vendors[i].ID, nil
+}
+
+func buildCustomEventTransformerStruct(d *schema.ResourceData) *customEventTransformer {
+	return &customEventTransformer{
+		Integration: pagerduty.Integration{
+			Name: d.Get("name").(string),
+			Type: "generic_events_api_inbound_integration",
+		},
+		Config: &customEventTransformerConfig{
+			Code: d.Get("code").(string),
+		},
+	}
+}
+
+func fetchPagerDutyCustomEventTransformer(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	service := d.Get("service").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		v := new(customEventTransformerPayload)
+		if _, err := apiRequest(client, "GET", customEventTransformerPath(service, d.Id()), nil, nil, v); err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		transformer := v.Integration
+		d.Set("name", transformer.Name)
+		d.Set("integration_key", transformer.IntegrationKey)
+		d.Set("html_url", transformer.HTMLURL)
+		if transformer.Vendor != nil {
+			d.Set("vendor", transformer.Vendor.ID)
+		}
+		if transformer.Config != nil {
+			d.Set("code", normalizeTransformerCode(transformer.Config.Code))
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyCustomEventTransformerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	vendorID, err := findCustomEventTransformerVendor(config, client)
+	if err != nil {
+		return err
+	}
+
+	transformer := buildCustomEventTransformerStruct(d)
+	transformer.Vendor = &pagerduty.VendorReference{
+		ID:   vendorID,
+		Type: "vendor_reference",
+	}
+
+	log.Printf("[INFO] Creating PagerDuty custom event transformer %s", transformer.Name)
+
+	service := d.Get("service").(string)
+
+	retryErr := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		v := new(customEventTransformerPayload)
+		if _, err := apiRequest(client, "POST", customEventTransformerPath(service, ""), nil, &customEventTransformerPayload{Integration: transformer}, v); err != nil {
+			if isErrCode(err, 400) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		} else if v.Integration != nil {
+			d.SetId(v.Integration.ID)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return fetchPagerDutyCustomEventTransformer(d, meta, genError)
+}
+
+func resourcePagerDutyCustomEventTransformerRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty custom event transformer %s", d.Id())
+	return fetchPagerDutyCustomEventTransformer(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyCustomEventTransformerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	transformer := buildCustomEventTransformerStruct(d)
+	service := d.Get("service").(string)
+
+	log.Printf("[INFO] Updating PagerDuty custom event transformer %s", d.Id())
+
+	if _, err := apiRequest(client, "PUT", customEventTransformerPath(service, d.Id()), nil, &customEventTransformerPayload{Integration: transformer}, nil); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyCustomEventTransformerRead(d, meta)
+}
+
+func resourcePagerDutyCustomEventTransformerDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	service := d.Get("service").(string)
+
+	log.Printf("[INFO] Removing PagerDuty custom event transformer %s", d.Id())
+
+	if _, err := client.Services.DeleteIntegration(service, d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyCustomEventTransformerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseCompositeImportID("pagerduty_custom_event_transformer", d.Id(), "service_id", "integration_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(ids[1])
+	d.Set("service", ids[0])
+
+	return []*schema.ResourceData{d}, nil
+}
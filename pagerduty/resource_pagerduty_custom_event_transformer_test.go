@@ -0,0 +1,137 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestNormalizeTransformerCode(t *testing.T) {
+	cases := map[string]string{
+		"var a = 1;":                         "var a = 1;",
+		"\nvar a = 1;  \r\nvar b = 2;\t\n\n": "var a = 1;\nvar b = 2;",
+		"  var a = 1;\n":                     "  var a = 1;",
+	}
+
+	for in, expected := range cases {
+		if got := normalizeTransformerCode(in); got != expected {
+			t.Errorf("normalizeTransformerCode(%q) = %q, expected %q", in, got, expected)
+		}
+	}
+}
+
+func TestAccPagerDutyCustomEventTransformer_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	transformer := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyCustomEventTransformerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyCustomEventTransformerConfig(username, email, escalationPolicy, service, transformer, "PD.inputRequest.body"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyCustomEventTransformerExists("pagerduty_custom_event_transformer.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_custom_event_transformer.foo", "name", transformer),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_custom_event_transformer.foo", "integration_key"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyCustomEventTransformerConfig(username, email, escalationPolicy, service, transformer, "PD.inputRequest.body.details"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyCustomEventTransformerExists("pagerduty_custom_event_transformer.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_custom_event_transformer.foo", "code", "var body = PD.inputRequest.body.details;\nPD.emitGenericEvents([{ event_type: PD.Trigger, description: body.title }]);"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyCustomEventTransformerDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_custom_event_transformer" {
+			continue
+		}
+
+		if _, _, err := client.Services.GetIntegration(r.Primary.Attributes["service"], r.Primary.ID, &pagerduty.GetIntegrationOptions{}); err == nil {
+			return fmt.Errorf("Custom event transformer still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyCustomEventTransformerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No custom event transformer ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.Services.GetIntegration(rs.Primary.Attributes["service"], rs.Primary.ID, &pagerduty.GetIntegrationOptions{})
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Custom event transformer not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyCustomEventTransformerConfig(username, email, escalationPolicy, service, transformer, input string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_custom_event_transformer" "foo" {
+  name    = "%s"
+  service = pagerduty_service.foo.id
+  code    = <<-EOT
+
+    var body = %s;   
+    PD.emitGenericEvents([{ event_type: PD.Trigger, description: body.title }]);
+  EOT
+}
+`, username, email, escalationPolicy, service, transformer, input)
+}
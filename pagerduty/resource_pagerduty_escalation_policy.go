@@ -1,10 +1,14 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,18 +21,42 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 		Read:   resourcePagerDutyEscalationPolicyRead,
 		Update: resourcePagerDutyEscalationPolicyUpdate,
 		Delete: resourcePagerDutyEscalationPolicyDelete,
+		CustomizeDiff: customdiff.All(
+			validateEscalationPolicyTargets,
+			validateReferences(
+				referenceAttribute{key: "teams", kind: "team"},
+			),
+		),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourcePagerDutyEscalationPolicyImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressHTMLEntityDiff,
 			},
 			"description": {
-				Type:     schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "Managed by Terraform",
+				DiffSuppressFunc: suppressHTMLEntityDiff,
+			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  "Managed by Terraform",
+				Default:  false,
 			},
 			"num_loops": {
 				Type:         schema.TypeInt,
@@ -105,6 +133,181 @@ func buildEscalationPolicyStruct(d *schema.ResourceData) *pagerduty.EscalationPo
 	return escalationPolicy
 }
 
+// validateEscalationPolicyTargets verifies that every known user and schedule
+// referenced by the escalation rules exists and that users are able to be
+// escalated to. It only runs when validate_escalation_targets is enabled on
+// the provider, since it costs one API call per target.
+func validateEscalationPolicyTargets(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config, ok := meta.(*Config)
+	if !ok || !config.ValidateEscalationTargets {
+		return nil
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	rules := diff.Get("rule.#").(int)
+	for i := 0; i < rules; i++ {
+		targets := diff.Get(fmt.Sprintf("rule.%d.target.#", i)).(int)
+		for j := 0; j < targets; j++ {
+			key := fmt.Sprintf("rule.%d.target.%d", i, j)
+			if !diff.NewValueKnown(key+".id") || !diff.NewValueKnown(key+".type") {
+				continue
+			}
+
+			id := diff.Get(key + ".id").(string)
+			targetType := diff.Get(key + ".type").(string)
+			if err := validateEscalationTarget(client, id, targetType); err != nil {
+				return fmt.Errorf("%s: %s", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// escalationPolicyWarnings returns the warnings about a new or changed
+// escalation policy, once it's applied.
+func escalationPolicyWarnings(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return append(escalationPolicyDuplicateTargetWarnings(d), escalationPolicyCoverageWarnings(d, meta)...)
+}
+
+// escalationPolicyDuplicateTargetWarnings warns about users and schedules
+// targeted more than once by the same escalation rule. The API accepts them,
+// but they are notified only once, which breaks the expectations of round
+// robin rules and is usually a copy-paste mistake.
+func escalationPolicyDuplicateTargetWarnings(d *schema.ResourceData) diag.Diagnostics {
+	if !d.IsNewResource() && !d.HasChange("rule") {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	rules := d.Get("rule.#").(int)
+	for i := 0; i < rules; i++ {
+		var targets []string
+		n := d.Get(fmt.Sprintf("rule.%d.target.#", i)).(int)
+		for j := 0; j < n; j++ {
+			key := fmt.Sprintf("rule.%d.target.%d", i, j)
+			targetType := strings.TrimSuffix(d.Get(key+".type").(string), "_reference")
+			targets = append(targets, fmt.Sprintf("%s %s", targetType, d.Get(key+".id")))
+		}
+
+		for _, target := range duplicateEscalationTargets(targets) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Escalation policy %q targets %s more than once in rule.%d", d.Get("name"), target, i),
+				Detail:   "The target is only notified once, which is usually a copy-paste mistake.",
+			})
+		}
+	}
+
+	return diags
+}
+
+// escalationPolicyCoverageWarnings warns when the first rule of a new or
+// changed escalation policy only targets schedules which have nobody on call
+// right now, e.g. because their layers start next week, as incidents would
+// then not notify anyone until they escalate. Users are always on call, so a
+// rule targeting one is never warned about. It runs once the policy is
+// applied rather than during plan, as only applies can return warnings.
+func escalationPolicyCoverageWarnings(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("rule.#").(int) == 0 {
+		return nil
+	}
+	if !d.IsNewResource() && !d.HasChange("rule.0.target") {
+		return nil
+	}
+
+	var schedules []string
+	targets := d.Get("rule.0.target.#").(int)
+	for j := 0; j < targets; j++ {
+		key := fmt.Sprintf("rule.0.target.%d", j)
+		if d.Get(key+".type").(string) != "schedule_reference" {
+			return nil
+		}
+		schedules = append(schedules, d.Get(key+".id").(string))
+	}
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	now := time.Now().UTC()
+	o := &pagerduty.ListOnCallsOptions{
+		Since: now.Format(time.RFC3339),
+		Until: now.Add(time.Minute).Format(time.RFC3339),
+	}
+	for _, id := range schedules {
+		resp, _, err := client.Schedules.ListOnCalls(id, o)
+		if err != nil {
+			// The warning is best effort and never fails the apply
+			log.Printf("[DEBUG] Could not list the users on call in schedule %s: %s", id, err)
+			return nil
+		}
+		if len(resp.Users) > 0 {
+			return nil
+		}
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Nobody is on call in the first rule of escalation policy %q", d.Get("name")),
+		Detail: fmt.Sprintf("Nobody is on call right now in the schedules targeted by the first rule (%s), "+
+			"so incidents will not notify anyone until they escalate to the next rule.", strings.Join(schedules, ", ")),
+	}}
+}
+
+// duplicateEscalationTargets returns the targets which appear more than once,
+// in the order of their first duplicate.
+func duplicateEscalationTargets(targets []string) []string {
+	var duplicates []string
+	count := make(map[string]int)
+	for _, target := range targets {
+		count[target]++
+		if count[target] == 2 {
+			duplicates = append(duplicates, target)
+		}
+	}
+	return duplicates
+}
+
+func validateEscalationTarget(client *pagerduty.Client, id, targetType string) error {
+	switch targetType {
+	case "user_reference":
+		user, _, err := client.Users.Get(id, &pagerduty.GetUserOptions{})
+		if err != nil {
+			if isErrCode(err, 404) {
+				return fmt.Errorf("user %s does not exist", id)
+			}
+			return err
+		}
+		if isStakeholderRole(user.Role) {
+			return fmt.Errorf("user %s has the %s role and cannot be an escalation target", id, user.Role)
+		}
+	case "schedule_reference":
+		if _, _, err := client.Schedules.Get(id, &pagerduty.GetScheduleOptions{}); err != nil {
+			if isErrCode(err, 404) {
+				return fmt.Errorf("schedule %s does not exist", id)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isStakeholderRole reports whether the given user role is a stakeholder
+// role, which PagerDuty does not allow to be on call or escalated to.
+func isStakeholderRole(role string) bool {
+	return role == "read_only_user" || role == "read_only_limited_user"
+}
+
 func resourcePagerDutyEscalationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -114,9 +317,30 @@ func resourcePagerDutyEscalationPolicyCreate(d *schema.ResourceData, meta interf
 
 	escalationPolicy := buildEscalationPolicyStruct(d)
 
+	if d.Get("adopt_existing").(bool) {
+		id, err := findExistingByName(client, "escalation policy", escalationPolicy.Name, listEscalationPolicyNames)
+		if err != nil {
+			return err
+		}
+		if id != "" {
+			existing, _, err := client.EscalationPolicies.Get(id, &pagerduty.GetEscalationPolicyOptions{})
+			if err != nil {
+				return err
+			}
+			teams := strings.Join(flattenTeams(existing.Teams), ", ")
+			if err := checkAdoptedAttribute("escalation policy", escalationPolicy.Name, id, "teams", teams, strings.Join(expandStringList(d.Get("teams").([]interface{})), ", ")); err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] Adopting existing PagerDuty escalation policy: %s (%s)", escalationPolicy.Name, id)
+			d.SetId(id)
+			return resourcePagerDutyEscalationPolicyUpdate(d, meta)
+		}
+	}
+
 	log.Printf("[INFO] Creating PagerDuty escalation policy: %s", escalationPolicy.Name)
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		escalationPolicy, _, err := client.EscalationPolicies.Create(escalationPolicy)
 		if err != nil {
 			if isErrCode(err, 429) {
@@ -130,7 +354,7 @@ func resourcePagerDutyEscalationPolicyCreate(d *schema.ResourceData, meta interf
 		}
 
 		d.SetId(escalationPolicy.ID)
-		readErr = resourcePagerDutyEscalationPolicyRead(d, meta)
+		readErr = fetchPagerDutyEscalationPolicy(d, meta, genError)
 		if readErr != nil {
 			return resource.NonRetryableError(readErr)
 		}
@@ -138,21 +362,24 @@ func resourcePagerDutyEscalationPolicyCreate(d *schema.ResourceData, meta interf
 	})
 }
 
-func resourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutyEscalationPolicy(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Reading PagerDuty escalation policy: %s", d.Id())
-
 	o := &pagerduty.GetEscalationPolicyOptions{}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		escalationPolicy, _, err := client.EscalationPolicies.Get(d.Id(), o)
 		if err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		}
 
 		d.Set("name", escalationPolicy.Name)
@@ -171,6 +398,11 @@ func resourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interfac
 	})
 }
 
+func resourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty escalation policy: %s", d.Id())
+	return fetchPagerDutyEscalationPolicy(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutyEscalationPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -179,9 +411,13 @@ func resourcePagerDutyEscalationPolicyUpdate(d *schema.ResourceData, meta interf
 
 	escalationPolicy := buildEscalationPolicyStruct(d)
 
+	if err := clearDescription(client, d, "/escalation_policies/"+d.Id(), "escalation_policy"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Updating PagerDuty escalation policy: %s", d.Id())
 
-	retryErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		if _, _, err := client.EscalationPolicies.Update(d.Id(), escalationPolicy); err != nil {
 			return resource.RetryableError(err)
 		}
@@ -201,10 +437,14 @@ func resourcePagerDutyEscalationPolicyDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	if err := checkDeletionProtection(d, "pagerduty_escalation_policy"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty escalation policy: %s", d.Id())
 
 	// Retrying to give other resources (such as services) to delete
-	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if _, err := client.EscalationPolicies.Delete(d.Id()); err != nil {
 			if isErrCode(err, 400) {
 				return resource.RetryableError(err)
@@ -226,6 +466,55 @@ func resourcePagerDutyEscalationPolicyDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
+// resourcePagerDutyEscalationPolicyImport accepts either an escalation policy
+// ID or its exact name, optionally prefixed with "name:". Names are resolved
+// through the list endpoint and must match exactly one escalation policy.
+func resourcePagerDutyEscalationPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	if looksLikePagerDutyID(d.Id()) {
+		_, _, err := client.EscalationPolicies.Get(d.Id(), &pagerduty.GetEscalationPolicyOptions{})
+		if err == nil {
+			return []*schema.ResourceData{d}, nil
+		}
+		if !isErrCode(err, 404) {
+			return []*schema.ResourceData{}, fmt.Errorf("error importing pagerduty_escalation_policy: %s", err)
+		}
+	}
+
+	name := strings.TrimPrefix(d.Id(), importByNamePrefix)
+
+	return importByName(d, meta, "pagerduty_escalation_policy", "escalation policy", name, listEscalationPolicyNames)
+}
+
+func listEscalationPolicyNames(client *pagerduty.Client, query string) ([]namedObject, error) {
+	o := &pagerduty.ListEscalationPoliciesOptions{
+		Query: query,
+	}
+
+	var objects []namedObject
+	for {
+		resp, _, err := client.EscalationPolicies.List(o)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, policy := range resp.EscalationPolicies {
+			objects = append(objects, namedObject{ID: policy.ID, Name: policy.Name})
+		}
+
+		if !resp.More {
+			break
+		}
+		o.Offset = resp.Offset + resp.Limit
+	}
+
+	return objects, nil
+}
+
 func expandEscalationRules(v interface{}) []*pagerduty.EscalationRule {
 	var escalationRules []*pagerduty.EscalationRule
 
@@ -3,9 +3,13 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -154,6 +158,151 @@ func TestAccPagerDutyEscalationPolicyWithTeams_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyEscalationPolicy_ValidateTargets(t *testing.T) {
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyEscalationPolicyValidateTargetsConfig(escalationPolicy),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("user PNOTREAL does not exist"),
+			},
+		},
+	})
+}
+
+func TestDuplicateEscalationTargets(t *testing.T) {
+	cases := []struct {
+		targets  []string
+		expected []string
+	}{
+		{nil, nil},
+		{[]string{"user P1", "schedule P1", "user P2"}, nil},
+		{[]string{"user P1", "user P2", "user P1", "user P1"}, []string{"user P1"}},
+		{[]string{"schedule P2", "user P1", "user P1", "schedule P2"}, []string{"user P1", "schedule P2"}},
+	}
+
+	for _, c := range cases {
+		if got := duplicateEscalationTargets(c.targets); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("expected the duplicates of %v to be %v, got %v", c.targets, c.expected, got)
+		}
+	}
+}
+
+func TestEscalationPolicyDuplicateTargetWarnings(t *testing.T) {
+	d := resourcePagerDutyEscalationPolicy().TestResourceData()
+	d.MarkNewResource()
+	d.Set("name", "foo")
+	d.Set("rule", []interface{}{
+		map[string]interface{}{
+			"escalation_delay_in_minutes": 10,
+			"target": []interface{}{
+				map[string]interface{}{"type": "user_reference", "id": "PUSER"},
+				map[string]interface{}{"type": "schedule_reference", "id": "PUSER"},
+			},
+		},
+		map[string]interface{}{
+			"escalation_delay_in_minutes": 10,
+			"target": []interface{}{
+				map[string]interface{}{"type": "user_reference", "id": "PUSER"},
+				map[string]interface{}{"type": "user_reference", "id": "PUSER"},
+			},
+		},
+	})
+
+	diags := escalationPolicyDuplicateTargetWarnings(d)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "user PUSER more than once in rule.1") {
+		t.Errorf("expected a warning about user PUSER in rule.1, got %v", diags)
+	}
+}
+
+func TestEscalationPolicyCoverageWarnings(t *testing.T) {
+	cases := []struct {
+		users    string
+		warnings int
+	}{
+		{`[]`, 1},
+		{`[{"id":"PUSER"}]`, 0},
+	}
+
+	for _, c := range cases {
+		config := testStubbedConfig(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/schedules/PSCHED/users" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			w.Write([]byte(`{"users":` + c.users + `}`))
+		})
+
+		d := resourcePagerDutyEscalationPolicy().TestResourceData()
+		d.MarkNewResource()
+		d.SetId("PPOLICY")
+		d.Set("name", "foo")
+		d.Set("rule", []interface{}{map[string]interface{}{
+			"escalation_delay_in_minutes": 10,
+			"target": []interface{}{map[string]interface{}{
+				"type": "schedule_reference",
+				"id":   "PSCHED",
+			}},
+		}})
+
+		diags := escalationPolicyCoverageWarnings(d, config)
+		if len(diags) != c.warnings {
+			t.Fatalf("expected %d warning(s) with %s on call, got %v", c.warnings, c.users, diags)
+		}
+		for _, warning := range diags {
+			if warning.Severity != diag.Warning {
+				t.Errorf("expected a warning, got %v", warning)
+			}
+		}
+	}
+}
+
+func TestProviderReportsEscalationPolicyWarnings(t *testing.T) {
+	r := Provider().ResourcesMap["pagerduty_escalation_policy"]
+	if r.Create != nil || r.CreateContext == nil || r.Update != nil || r.UpdateContext == nil {
+		t.Errorf("expected the create and update of pagerduty_escalation_policy to be able to return warnings")
+	}
+}
+
+func TestAccPagerDutyEscalationPolicy_DeletionProtection(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyDeletionProtectionConfig(username, email, escalationPolicy, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyEscalationPolicyDeletionProtectionConfig(username, email, escalationPolicy, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection is enabled"),
+			},
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyDeletionProtectionConfig(username, email, escalationPolicy, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyEscalationPolicyDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -334,3 +483,55 @@ resource "pagerduty_escalation_policy" "foo" {
 }
 `, name, email, team, escalationPolicy)
 }
+
+func testAccCheckPagerDutyEscalationPolicyValidateTargetsConfig(escalationPolicy string) string {
+	return fmt.Sprintf(`
+provider "pagerduty" {
+  validate_escalation_targets = true
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = "PNOTREAL"
+    }
+  }
+}
+`, escalationPolicy)
+}
+
+func testAccCheckPagerDutyEscalationPolicyDeletionProtectionConfig(name, email, escalationPolicy string, protected bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+  color       = "green"
+  role        = "user"
+  job_title   = "foo"
+  description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name                = "%s"
+  description         = "foo"
+  num_loops           = 1
+  deletion_protection = %t
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+`, name, email, escalationPolicy, protected)
+}
@@ -0,0 +1,331 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// escalationRuleMu serializes changes made through pagerduty_escalation_rule.
+// Rules are not a first-class API object, so every change rewrites the full
+// rule list of the parent escalation policy and concurrent applies on the
+// same policy would otherwise overwrite each other.
+var escalationRuleMu sync.Mutex
+
+func resourcePagerDutyEscalationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyEscalationRuleCreate,
+		Read:   resourcePagerDutyEscalationRuleRead,
+		Update: resourcePagerDutyEscalationRuleUpdate,
+		Delete: resourcePagerDutyEscalationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyEscalationRuleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"escalation_policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"escalation_delay_in_minutes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "user_reference",
+							ValidateFunc: validateValueFunc([]string{
+								"user_reference",
+								"schedule_reference",
+							}),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildEscalationRuleStruct(d *schema.ResourceData) *pagerduty.EscalationRule {
+	rule := &pagerduty.EscalationRule{
+		EscalationDelayInMinutes: d.Get("escalation_delay_in_minutes").(int),
+	}
+
+	for _, t := range d.Get("target").([]interface{}) {
+		target := t.(map[string]interface{})
+		rule.Targets = append(rule.Targets, &pagerduty.EscalationTargetReference{
+			ID:   target["id"].(string),
+			Type: target["type"].(string),
+		})
+	}
+
+	return rule
+}
+
+// insertEscalationRule returns a copy of rules with rule inserted at the
+// given position. A negative or out of range position appends the rule.
+func insertEscalationRule(rules []*pagerduty.EscalationRule, rule *pagerduty.EscalationRule, position int) []*pagerduty.EscalationRule {
+	if position < 0 || position > len(rules) {
+		position = len(rules)
+	}
+
+	result := make([]*pagerduty.EscalationRule, 0, len(rules)+1)
+	result = append(result, rules[:position]...)
+	result = append(result, rule)
+	return append(result, rules[position:]...)
+}
+
+// removeEscalationRule returns a copy of rules without the rule with the
+// given ID, along with the position the rule was found at or -1.
+func removeEscalationRule(rules []*pagerduty.EscalationRule, id string) ([]*pagerduty.EscalationRule, int) {
+	result := make([]*pagerduty.EscalationRule, 0, len(rules))
+	position := -1
+	for i, r := range rules {
+		if r.ID == id {
+			position = i
+			continue
+		}
+		result = append(result, r)
+	}
+
+	return result, position
+}
+
+// updateEscalationPolicyRules fetches the parent escalation policy, lets fn
+// rewrite its rules and saves the policy back, returning the saved policy.
+func updateEscalationPolicyRules(client *pagerduty.Client, policyID string, fn func([]*pagerduty.EscalationRule) ([]*pagerduty.EscalationRule, error)) (*pagerduty.EscalationPolicy, error) {
+	escalationRuleMu.Lock()
+	defer escalationRuleMu.Unlock()
+
+	var updated *pagerduty.EscalationPolicy
+
+	retryErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		policy, _, err := client.EscalationPolicies.Get(policyID, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		rules, err := fn(policy.EscalationRules)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		policy.EscalationRules = rules
+		updated, _, err = client.EscalationPolicies.Update(policyID, policy)
+		if err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	return updated, retryErr
+}
+
+func resourcePagerDutyEscalationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	policyID := d.Get("escalation_policy").(string)
+	rule := buildEscalationRuleStruct(d)
+
+	position := -1
+	if v, ok := d.GetOkExists("position"); ok {
+		position = v.(int)
+	}
+
+	log.Printf("[INFO] Creating PagerDuty escalation rule in escalation policy: %s", policyID)
+
+	existing := make(map[string]bool)
+	policy, err := updateEscalationPolicyRules(client, policyID, func(rules []*pagerduty.EscalationRule) ([]*pagerduty.EscalationRule, error) {
+		for _, r := range rules {
+			existing[r.ID] = true
+		}
+		return insertEscalationRule(rules, rule, position), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range policy.EscalationRules {
+		if !existing[r.ID] {
+			d.SetId(r.ID)
+			break
+		}
+	}
+
+	if d.Id() == "" {
+		return fmt.Errorf("Error creating escalation rule in escalation policy %s: the new rule was not returned by PagerDuty", policyID)
+	}
+
+	return resourcePagerDutyEscalationRuleRead(d, meta)
+}
+
+func resourcePagerDutyEscalationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	policyID := d.Get("escalation_policy").(string)
+
+	log.Printf("[INFO] Reading PagerDuty escalation rule %s in escalation policy: %s", d.Id(), policyID)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		policy, _, err := client.EscalationPolicies.Get(policyID, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			if isErrCode(err, 404) {
+				log.Printf("[DEBUG] Escalation policy %s of escalation rule %s is gone", policyID, d.Id())
+				d.SetId("")
+				return nil
+			}
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		for i, r := range policy.EscalationRules {
+			if r.ID != d.Id() {
+				continue
+			}
+
+			d.Set("position", i)
+			d.Set("escalation_delay_in_minutes", r.EscalationDelayInMinutes)
+
+			var targets []map[string]interface{}
+			for _, t := range r.Targets {
+				targets = append(targets, map[string]interface{}{"id": t.ID, "type": t.Type})
+			}
+			if err := d.Set("target", targets); err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		}
+
+		log.Printf("[DEBUG] Escalation rule %s is gone from escalation policy %s", d.Id(), policyID)
+		d.SetId("")
+		return nil
+	})
+}
+
+func resourcePagerDutyEscalationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	policyID := d.Get("escalation_policy").(string)
+	rule := buildEscalationRuleStruct(d)
+	rule.ID = d.Id()
+
+	log.Printf("[INFO] Updating PagerDuty escalation rule %s in escalation policy: %s", d.Id(), policyID)
+
+	_, err = updateEscalationPolicyRules(client, policyID, func(rules []*pagerduty.EscalationRule) ([]*pagerduty.EscalationRule, error) {
+		remaining, current := removeEscalationRule(rules, d.Id())
+		if current == -1 {
+			return nil, fmt.Errorf("Escalation rule %s not found in escalation policy %s", d.Id(), policyID)
+		}
+
+		position := current
+		if v, ok := d.GetOkExists("position"); ok {
+			position = v.(int)
+		}
+
+		return insertEscalationRule(remaining, rule, position), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourcePagerDutyEscalationRuleRead(d, meta)
+}
+
+func resourcePagerDutyEscalationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	policyID := d.Get("escalation_policy").(string)
+
+	log.Printf("[INFO] Deleting PagerDuty escalation rule %s in escalation policy: %s", d.Id(), policyID)
+
+	_, err = updateEscalationPolicyRules(client, policyID, func(rules []*pagerduty.EscalationRule) ([]*pagerduty.EscalationRule, error) {
+		remaining, _ := removeEscalationRule(rules, d.Id())
+		if len(remaining) == 0 {
+			return nil, fmt.Errorf("Escalation rule %s is the last rule of escalation policy %s and can't be deleted", d.Id(), policyID)
+		}
+		return remaining, nil
+	})
+	if err != nil {
+		if isErrCode(err, 404) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyEscalationRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids, err := parseCompositeImportID("pagerduty_escalation_rule", d.Id(), "escalation_policy_id", "escalation_rule_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	policyID, ruleID := ids[0], ids[1]
+
+	policy, _, err := client.EscalationPolicies.Get(policyID, &pagerduty.GetEscalationPolicyOptions{})
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	if _, position := removeEscalationRule(policy.EscalationRules, ruleID); position == -1 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_escalation_rule. Escalation rule %s not found in escalation policy %s", ruleID, policyID)
+	}
+
+	d.SetId(ruleID)
+	d.Set("escalation_policy", policyID)
+
+	return []*schema.ResourceData{d}, nil
+}
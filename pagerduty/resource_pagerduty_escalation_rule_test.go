@@ -0,0 +1,138 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccPagerDutyEscalationRule_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEscalationRuleConfig(username, email, escalationPolicy, 1, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationRuleExists("pagerduty_escalation_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_rule.foo", "position", "1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_rule.foo", "escalation_delay_in_minutes", "20"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_rule.foo", "target.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEscalationRuleConfig(username, email, escalationPolicy, 0, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationRuleExists("pagerduty_escalation_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_rule.foo", "position", "0"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_rule.foo", "escalation_delay_in_minutes", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEscalationRuleDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_escalation_rule" {
+			continue
+		}
+
+		policy, _, err := client.EscalationPolicies.Get(r.Primary.Attributes["escalation_policy"], &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			continue
+		}
+
+		for _, rule := range policy.EscalationRules {
+			if rule.ID == r.Primary.ID {
+				return fmt.Errorf("Escalation Rule still exists")
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyEscalationRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Escalation Rule ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		policy, _, err := client.EscalationPolicies.Get(rs.Primary.Attributes["escalation_policy"], &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range policy.EscalationRules {
+			if rule.ID == rs.Primary.ID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Escalation Rule not found: %v", rs.Primary.ID)
+	}
+}
+
+func testAccCheckPagerDutyEscalationRuleConfig(name, email, escalationPolicy string, position, delay int) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+  color       = "green"
+  role        = "user"
+  job_title   = "foo"
+  description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [rule]
+  }
+}
+
+resource "pagerduty_escalation_rule" "foo" {
+  escalation_policy           = pagerduty_escalation_policy.foo.id
+  position                    = %d
+  escalation_delay_in_minutes = %d
+
+  target {
+    type = "user_reference"
+    id   = pagerduty_user.foo.id
+  }
+}
+`, name, email, escalationPolicy, position, delay)
+}
@@ -16,7 +16,12 @@ func resourcePagerDutyEventOrchestration() *schema.Resource {
 		Update: resourcePagerDutyEventOrchestrationUpdate,
 		Delete: resourcePagerDutyEventOrchestrationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("pagerduty_event_orchestration", "event orchestration", listEventOrchestrationNames),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Second),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Second),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -27,6 +32,11 @@ func resourcePagerDutyEventOrchestration() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"team": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -102,7 +112,7 @@ func resourcePagerDutyEventOrchestrationCreate(d *schema.ResourceData, meta inte
 
 	log.Printf("[INFO] Creating PagerDuty Event Orchestration: %s", payload.Name)
 
-	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		if orch, _, err := client.EventOrchestrations.Create(payload); err != nil {
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				return resource.RetryableError(err)
@@ -131,7 +141,7 @@ func resourcePagerDutyEventOrchestrationRead(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		orch, _, err := client.EventOrchestrations.Get(d.Id())
 		if err != nil {
 			errResp := handleNotFoundError(err, d)
@@ -159,7 +169,7 @@ func resourcePagerDutyEventOrchestrationUpdate(d *schema.ResourceData, meta inte
 
 	log.Printf("[INFO] Updating PagerDuty Event Orchestration: %s", d.Id())
 
-	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		if _, _, err := client.EventOrchestrations.Update(d.Id(), orchestration); err != nil {
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				return resource.RetryableError(err)
@@ -183,6 +193,10 @@ func resourcePagerDutyEventOrchestrationDelete(d *schema.ResourceData, meta inte
 		return err
 	}
 
+	if err := checkDeletionProtection(d, "pagerduty_event_orchestration"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty Event Orchestration: %s", d.Id())
 	if _, err := client.EventOrchestrations.Delete(d.Id()); err != nil {
 		return err
@@ -238,3 +252,19 @@ func setEventOrchestrationProps(d *schema.ResourceData, o *pagerduty.EventOrches
 
 	return nil
 }
+
+// listEventOrchestrationNames lists all the event orchestrations, as the API
+// can't search them by name.
+func listEventOrchestrationNames(client *pagerduty.Client, query string) ([]namedObject, error) {
+	resp, _, err := client.EventOrchestrations.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []namedObject
+	for _, orchestration := range resp.Orchestrations {
+		objects = append(objects, namedObject{ID: orchestration.ID, Name: orchestration.Name})
+	}
+
+	return objects, nil
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -89,7 +90,7 @@ var eventOrchestrationPathServiceCatchAllActionsSchema = map[string]*schema.Sche
 	"severity": {
 		Type:         schema.TypeString,
 		Optional:     true,
-		ValidateFunc: validateEventOrchestrationPathSeverity(),
+		ValidateFunc: validateSeverity(),
 	},
 	"event_action": {
 		Type:         schema.TypeString,
@@ -133,7 +134,20 @@ func resourcePagerDutyEventOrchestrationPathService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyEventOrchestrationPathServiceImport,
 		},
-		CustomizeDiff: checkExtractions,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Second),
+		},
+		CustomizeDiff: customdiff.All(
+			checkExtractions,
+			checkVariables,
+			logEventOrchestrationRuleChanges,
+			validateReferences(
+				referenceAttribute{key: "set.*.rule.*.actions.*.priority", kind: "priority"},
+				referenceAttribute{key: "catch_all.*.actions.*.priority", kind: "priority"},
+			),
+		),
 		Schema: map[string]*schema.Schema{
 			"service": {
 				Type:     schema.TypeString,
@@ -213,14 +227,19 @@ func resourcePagerDutyEventOrchestrationPathServiceRead(d *schema.ResourceData,
 		return err
 	}
 
-	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		id := d.Id()
 		t := "service"
 		log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type %s for orchestration: %s", t, id)
 
 		if path, _, err := client.EventOrchestrationPaths.Get(d.Id(), t); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if path != nil {
 			setEventOrchestrationPathServiceProps(d, path)
 		}
@@ -244,7 +263,7 @@ func resourcePagerDutyEventOrchestrationPathServiceUpdate(d *schema.ResourceData
 
 	log.Printf("[INFO] Creating PagerDuty Event Orchestration Service Path: %s", payload.Parent.ID)
 
-	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
+	retryErr := resource.Retry(createOrUpdateTimeout(d), func() *resource.RetryError {
 		if path, _, err := client.EventOrchestrationPaths.Update(payload.Parent.ID, "service", payload); err != nil {
 			return resource.RetryableError(err)
 		} else if path != nil {
@@ -111,6 +111,21 @@ func TestAccPagerDutyEventOrchestrationPathService_Basic(t *testing.T) {
 				PlanOnly:    true,
 				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: source can't be blank"),
 			},
+			// Providing invalid regular expressions
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
+					escalationPolicy, service, invalidExtractionRegexSyntaxConfig(), "",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`set.0.rule.0.actions.0.extraction.0.regex: "host-\(\\\\d\+" is not a valid RE2 regular expression: error parsing regexp: missing closing \)`),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
+					escalationPolicy, service, "", invalidVariableRegexSyntaxConfig(),
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`catch_all.0.actions.0.variable.0.value: "\[a-z" is not a valid RE2 regular expression: error parsing regexp: missing closing \]`),
+			},
 			// Adding/updating/deleting all actions
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsConfig(escalationPolicy, service),
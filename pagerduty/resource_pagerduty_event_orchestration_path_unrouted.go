@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -19,7 +20,12 @@ func resourcePagerDutyEventOrchestrationPathUnrouted() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyEventOrchestrationPathUnroutedImport,
 		},
-		CustomizeDiff: checkExtractions,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Second),
+		},
+		CustomizeDiff: customdiff.All(checkExtractions, checkVariables, logEventOrchestrationRuleChanges),
 		Schema: map[string]*schema.Schema{
 			"event_orchestration": {
 				Type:     schema.TypeString,
@@ -68,7 +74,7 @@ func resourcePagerDutyEventOrchestrationPathUnrouted() *schema.Resource {
 												"severity": {
 													Type:         schema.TypeString,
 													Optional:     true,
-													ValidateFunc: validateEventOrchestrationPathSeverity(),
+													ValidateFunc: validateSeverity(),
 												},
 												"event_action": {
 													Type:         schema.TypeString,
@@ -119,14 +125,9 @@ func resourcePagerDutyEventOrchestrationPathUnrouted() *schema.Resource {
 										Computed: true,
 									},
 									"severity": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ValidateFunc: validateValueFunc([]string{
-											"info",
-											"error",
-											"warning",
-											"critical",
-										}),
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateSeverity(),
 									},
 									"event_action": {
 										Type:     schema.TypeString,
@@ -166,13 +167,18 @@ func resourcePagerDutyEventOrchestrationPathUnroutedRead(d *schema.ResourceData,
 		return err
 	}
 
-	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 
 		log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type: %s for orchestration: %s", "unrouted", d.Id())
 
 		if unroutedPath, _, err := client.EventOrchestrationPaths.Get(d.Id(), "unrouted"); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if unroutedPath != nil {
 			if unroutedPath.Sets != nil {
 				d.Set("set", flattenUnroutedSets(unroutedPath.Sets))
@@ -212,7 +218,7 @@ func resourcePagerDutyEventOrchestrationPathUnroutedUpdate(d *schema.ResourceDat
 }
 
 func performUnroutedPathUpdate(d *schema.ResourceData, unroutedPath *pagerduty.EventOrchestrationPath, client *pagerduty.Client) error {
-	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
+	retryErr := resource.Retry(createOrUpdateTimeout(d), func() *resource.RetryError {
 		updatedPath, _, err := client.EventOrchestrationPaths.Update(unroutedPath.Parent.ID, "unrouted", unroutedPath)
 		if err != nil {
 			return resource.RetryableError(err)
@@ -221,6 +221,21 @@ func TestAccPagerDutyEventOrchestrationPathUnrouted_Basic(t *testing.T) {
 				PlanOnly:    true,
 				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: source can't be blank"),
 			},
+			// Providing invalid regular expressions
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathUnroutedInvalidExtractionsConfig(
+					team, escalationPolicy, service, orchestration, invalidExtractionRegexSyntaxConfig(), "",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`set.0.rule.0.actions.0.extraction.0.regex: "host-\(\\\\d\+" is not a valid RE2 regular expression: error parsing regexp: missing closing \)`),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathUnroutedInvalidExtractionsConfig(
+					team, escalationPolicy, service, orchestration, "", invalidVariableRegexSyntaxConfig(),
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`catch_all.0.actions.0.variable.0.value: "\[a-z" is not a valid RE2 regular expression: error parsing regexp: missing closing \]`),
+			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathUnroutedConfigNoRules(team, escalationPolicy, service, orchestration),
 				Check: resource.ComposeTestCheckFunc(
@@ -1,9 +1,11 @@
 package pagerduty
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -22,6 +24,7 @@ func resourcePagerDutyExtension() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyExtensionImport,
 		},
+		CustomizeDiff: validateExtensionConfig,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -58,6 +61,7 @@ func resourcePagerDutyExtension() *schema.Resource {
 				Optional:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: structure.SuppressJsonDiff,
+				StateFunc:        normalizeJSONString,
 			},
 			"summary": {
 				Type:     schema.TypeString,
@@ -113,7 +117,17 @@ func fetchPagerDutyExtension(d *schema.ResourceData, meta interface{}, errCallba
 		}
 		d.Set("extension_schema", extension.ExtensionSchema.ID)
 
-		if err := d.Set("config", flattenExtensionConfig(extension.Config)); err != nil {
+		// The old value is the prior state, and the new one the configuration
+		// when applying, or the prior state again when refreshing.
+		var known []interface{}
+		o, n := d.GetChange("config")
+		for _, v := range []interface{}{o, n} {
+			if v.(string) != "" {
+				known = append(known, expandExtensionConfig(v))
+			}
+		}
+		config := pruneExtensionConfigKeys(extension.Config, known...)
+		if err := d.Set("config", flattenExtensionConfig(config)); err != nil {
 			log.Printf("[WARN] error setting extension config: %s", err)
 		}
 
@@ -173,7 +187,7 @@ func resourcePagerDutyExtensionDelete(d *schema.ResourceData, meta interface{})
 
 	if _, err := client.Extensions.Delete(d.Id()); err != nil {
 		if perr, ok := err.(*pagerduty.Error); ok && perr.Code == 5001 {
-			log.Printf("[WARN] Extension (%s) not found, removing from state", d.Id())
+			log.Printf("[DEBUG] Extension (%s) not found", d.Id())
 			return nil
 		}
 		return err
@@ -246,3 +260,178 @@ func flattenExtensionConfig(config interface{}) interface{} {
 	}
 	return string(json)
 }
+
+// pruneExtensionConfigKeys removes the keys the API added to the config of an
+// extension, e.g. default values, which are neither in the prior state nor in
+// the configuration, so that they don't show up as a diff. The keys in either
+// are kept, so that changes made to them outside of Terraform show as drift.
+func pruneExtensionConfigKeys(config interface{}, known ...interface{}) interface{} {
+	c, ok := config.(map[string]interface{})
+	if !ok {
+		return config
+	}
+
+	var objects []map[string]interface{}
+	for _, k := range known {
+		if o, ok := k.(map[string]interface{}); ok {
+			objects = append(objects, o)
+		}
+	}
+	if len(objects) == 0 {
+		return config
+	}
+
+	result := make(map[string]interface{}, len(c))
+	for k, v := range c {
+		var values []interface{}
+		for _, o := range objects {
+			if kv, ok := o[k]; ok {
+				values = append(values, kv)
+			}
+		}
+		if len(values) > 0 {
+			result[k] = pruneExtensionConfigKeys(v, values...)
+		}
+	}
+
+	return result
+}
+
+// extensionConfigField describes a field of the config of an extension.
+type extensionConfigField struct {
+	Type     string
+	Required bool
+	Values   []string
+	Fields   map[string]*extensionConfigField
+}
+
+// knownExtensionConfigs maps the label of an extension schema to the fields
+// of its config. The config of extensions of other schemas is not validated.
+var knownExtensionConfigs = map[string]map[string]*extensionConfigField{
+	"Generic V2 Webhook": {
+		"restrict": {Type: "string", Values: []string{"any", "pd-users"}},
+		"notify_types": {Type: "object", Fields: map[string]*extensionConfigField{
+			"resolve":     {Type: "bool"},
+			"acknowledge": {Type: "bool"},
+			"assignments": {Type: "bool"},
+		}},
+		"referer": {Type: "string"},
+	},
+	"ServiceNow (v7)": {
+		"snow_user":     {Type: "string", Required: true},
+		"snow_password": {Type: "string"},
+		"sync_options":  {Type: "string", Required: true, Values: []string{"manual_sync", "sync_all"}},
+		"target":        {Type: "string", Required: true},
+		"task_type":     {Type: "string", Required: true},
+		"referer":       {Type: "string"},
+	},
+}
+
+// validateExtensionConfig checks the required fields of the config of an
+// extension, and the types of the fields it knows about, when its extension
+// schema is a known one.
+func validateExtensionConfig(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("config") && !diff.HasChange("extension_schema") {
+		return nil
+	}
+	if !diff.NewValueKnown("config") || !diff.NewValueKnown("extension_schema") {
+		return nil
+	}
+
+	raw := diff.Get("config").(string)
+	schemaID := diff.Get("extension_schema").(string)
+	if raw == "" || schemaID == "" {
+		return nil
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	extensionSchema, _, err := client.ExtensionSchemas.Get(schemaID)
+	if err != nil {
+		// The config is validated by PagerDuty when applying anyway
+		log.Printf("[WARN] Unable to read extension schema %s to validate the extension config: %s", schemaID, err)
+		return nil
+	}
+
+	fields, ok := knownExtensionConfigs[extensionSchema.Label]
+	if !ok {
+		return nil
+	}
+
+	var config interface{}
+	if err := json.Unmarshal([]byte(raw), &config); err != nil {
+		return err
+	}
+
+	return validateExtensionConfigFields(extensionSchema.Label, "config", config, fields)
+}
+
+func validateExtensionConfigFields(label, path string, v interface{}, fields map[string]*extensionConfigField) error {
+	config, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s of a %s extension must be a JSON object", path, label)
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := config[name]; !ok && fields[name].Required {
+			return fmt.Errorf("%s.%s is required for a %s extension", path, name, label)
+		}
+	}
+
+	keys := make([]string, 0, len(config))
+	for k := range config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		// Newer versions of the extension schema may add fields, which
+		// PagerDuty validates when applying.
+		field, ok := fields[k]
+		if !ok {
+			log.Printf("[DEBUG] Not validating %s.%s, which isn't a known field of a %s extension", path, k, label)
+			continue
+		}
+
+		value := config[k]
+		switch field.Type {
+		case "string":
+			s, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("%s.%s of a %s extension must be a string", path, k, label)
+			}
+			if len(field.Values) > 0 && !isValueInList(s, field.Values) {
+				return fmt.Errorf("%s.%s of a %s extension must be one of %v, got: %q", path, k, label, field.Values, s)
+			}
+		case "bool":
+			if _, ok := value.(bool); !ok {
+				return fmt.Errorf("%s.%s of a %s extension must be a boolean", path, k, label)
+			}
+		case "object":
+			if err := validateExtensionConfigFields(label, path+"."+k, value, field.Fields); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func isValueInList(v string, values []string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+
+	return false
+}
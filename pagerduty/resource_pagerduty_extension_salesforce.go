@@ -0,0 +1,245 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+type PagerDutyExtensionSalesforceConfig struct {
+	InstanceURL    string                   `json:"salesforce_instance_url"`
+	User           string                   `json:"salesforce_user"`
+	Password       string                   `json:"salesforce_password,omitempty"`
+	CaseRecordType string                   `json:"salesforce_case_record_type,omitempty"`
+	SyncDirection  string                   `json:"sync_direction"`
+	FieldMappings  []*extensionFieldMapping `json:"field_mappings"`
+}
+
+func resourcePagerDutyExtensionSalesforce() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyExtensionSalesforceCreate,
+		Read:   resourcePagerDutyExtensionSalesforceRead,
+		Update: resourcePagerDutyExtensionSalesforceUpdate,
+		Delete: resourcePagerDutyExtensionSalesforceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyExtensionSalesforceImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"summary": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_url": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"extension_objects": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"extension_schema": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+			"salesforce_instance_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"salesforce_user": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"salesforce_case_record_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"salesforce_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				StateFunc: hashSensitiveValue,
+			},
+			"sync_direction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "two_way",
+				ValidateFunc: validateValueFunc([]string{
+					"one_way",
+					"two_way",
+				}),
+			},
+			"field_mapping": extensionFieldMappingSchema(),
+		},
+	}
+}
+
+func buildExtensionSalesforceStruct(d *schema.ResourceData) *pagerduty.Extension {
+	extension := &pagerduty.Extension{
+		Name:        d.Get("name").(string),
+		Type:        "extension",
+		EndpointURL: d.Get("endpoint_url").(string),
+		ExtensionSchema: &pagerduty.ExtensionSchemaReference{
+			Type: "extension_schema_reference",
+			ID:   d.Get("extension_schema").(string),
+		},
+		ExtensionObjects: expandServiceObjects(d.Get("extension_objects")),
+	}
+
+	config := &PagerDutyExtensionSalesforceConfig{
+		InstanceURL:    d.Get("salesforce_instance_url").(string),
+		User:           d.Get("salesforce_user").(string),
+		CaseRecordType: d.Get("salesforce_case_record_type").(string),
+		SyncDirection:  d.Get("sync_direction").(string),
+		FieldMappings:  expandExtensionFieldMappings(d.Get("field_mapping")),
+	}
+
+	// The state only keeps a hash of the password, so it's only sent when it
+	// has been set or changed in the configuration.
+	if d.HasChange("salesforce_password") {
+		config.Password = d.Get("salesforce_password").(string)
+	}
+	extension.Config = config
+
+	return extension
+}
+
+func fetchPagerDutyExtensionSalesforce(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		extension, _, err := client.Extensions.Get(d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("summary", extension.Summary)
+		d.Set("name", extension.Name)
+		d.Set("endpoint_url", extension.EndpointURL)
+		d.Set("html_url", extension.HTMLURL)
+		if err := d.Set("extension_objects", flattenExtensionObjects(extension.ExtensionObjects)); err != nil {
+			log.Printf("[WARN] error setting extension_objects: %s", err)
+		}
+		d.Set("extension_schema", extension.ExtensionSchema.ID)
+
+		b, _ := json.Marshal(extension.Config)
+		config := new(PagerDutyExtensionSalesforceConfig)
+		json.Unmarshal(b, config)
+		d.Set("salesforce_instance_url", config.InstanceURL)
+		d.Set("salesforce_user", config.User)
+		d.Set("salesforce_case_record_type", config.CaseRecordType)
+		// salesforce_password is write-only, PagerDuty doesn't return it
+		d.Set("sync_direction", config.SyncDirection)
+		if err := d.Set("field_mapping", flattenExtensionFieldMappings(config.FieldMappings)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyExtensionSalesforceCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	extension := buildExtensionSalesforceStruct(d)
+
+	log.Printf("[INFO] Creating PagerDuty Salesforce extension %s", extension.Name)
+
+	extension, _, err = client.Extensions.Create(extension)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(extension.ID)
+
+	return fetchPagerDutyExtensionSalesforce(d, meta, genError)
+}
+
+func resourcePagerDutyExtensionSalesforceRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty Salesforce extension %s", d.Id())
+	return fetchPagerDutyExtensionSalesforce(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyExtensionSalesforceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	extension := buildExtensionSalesforceStruct(d)
+
+	log.Printf("[INFO] Updating PagerDuty Salesforce extension %s", d.Id())
+
+	if _, _, err := client.Extensions.Update(d.Id(), extension); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyExtensionSalesforceRead(d, meta)
+}
+
+func resourcePagerDutyExtensionSalesforceDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting PagerDuty Salesforce extension %s", d.Id())
+
+	if _, err := client.Extensions.Delete(d.Id()); err != nil {
+		if perr, ok := err.(*pagerduty.Error); ok && perr.Code == 5001 {
+			log.Printf("[DEBUG] Extension (%s) not found", d.Id())
+			return nil
+		}
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyExtensionSalesforceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	extension, _, err := client.Extensions.Get(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, fmt.Errorf("error importing pagerduty_extension_salesforce. Expecting an importation ID for extension")
+	}
+
+	d.Set("extension_objects", flattenExtensionObjects(extension.ExtensionObjects))
+	d.Set("extension_schema", extension.ExtensionSchema.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
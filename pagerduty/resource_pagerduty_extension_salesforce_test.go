@@ -0,0 +1,135 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyExtensionSalesforce_Basic(t *testing.T) {
+	extension_name := resource.PrefixedUniqueId("tf-")
+	extension_name_updated := resource.PrefixedUniqueId("tf-")
+	name := resource.PrefixedUniqueId("tf-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyExtensionSalesforceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyExtensionSalesforceConfig(name, extension_name, "two_way"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyExtensionSalesforceExists("pagerduty_extension_salesforce.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_salesforce.foo", "name", extension_name),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_salesforce.foo", "salesforce_password", hashSensitiveValue("zorz")),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_salesforce.foo", "sync_direction", "two_way"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_salesforce.foo", "field_mapping.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyExtensionSalesforceConfig(name, extension_name_updated, "one_way"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyExtensionSalesforceExists("pagerduty_extension_salesforce.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_salesforce.foo", "name", extension_name_updated),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_salesforce.foo", "sync_direction", "one_way"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyExtensionSalesforceDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_extension_salesforce" {
+			continue
+		}
+
+		if _, _, err := client.Extensions.Get(r.Primary.ID); err == nil {
+			return fmt.Errorf("Extension still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyExtensionSalesforceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No extension ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.Extensions.Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Extension not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyExtensionSalesforceConfig(name, extension_name, sync_direction string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s"
+  email = "%[1]s@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[1]s"
+  num_loops = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+data "pagerduty_extension_schema" "foo" {
+  name = "%[2]s"
+}
+
+resource "pagerduty_extension_salesforce" "foo" {
+  name              = "%[3]s"
+  extension_schema  = data.pagerduty_extension_schema.foo.id
+  extension_objects = [pagerduty_service.foo.id]
+  salesforce_instance_url     = "https://acme.my.salesforce.com"
+  salesforce_user             = "pagerduty@example.com"
+  salesforce_password         = "zorz"
+  salesforce_case_record_type = "Incident"
+  sync_direction              = "%[4]s"
+
+  field_mapping {
+    pagerduty_field = "incident_title"
+    external_field  = "Subject"
+  }
+}
+`, name, "Salesforce Service Cloud", extension_name, sync_direction)
+}
@@ -69,6 +69,7 @@ func resourcePagerDutyExtensionServiceNow() *schema.Resource {
 				Type:      schema.TypeString,
 				Required:  true,
 				Sensitive: true,
+				StateFunc: hashSensitiveValue,
 			},
 			"summary": {
 				Type:     schema.TypeString,
@@ -110,12 +111,18 @@ func buildExtensionServiceNowStruct(d *schema.ResourceData) *pagerduty.Extension
 
 	var config = &PagerDutyExtensionServiceNowConfig{
 		User:        d.Get("snow_user").(string),
-		Password:    d.Get("snow_password").(string),
 		SyncOptions: d.Get("sync_options").(string),
 		Target:      d.Get("target").(string),
 		TaskType:    d.Get("task_type").(string),
 		Referer:     d.Get("referer").(string),
 	}
+
+	// The state only keeps a hash of the password, so it's only sent when it
+	// has been set or changed in the configuration. PagerDuty keeps the
+	// current password when it's omitted.
+	if d.HasChange("snow_password") {
+		config.Password = d.Get("snow_password").(string)
+	}
 	Extension.Config = config
 
 	return Extension
@@ -152,7 +159,7 @@ func fetchPagerDutyExtensionServiceNowCreate(d *schema.ResourceData, meta interf
 		var config = new(PagerDutyExtensionServiceNowConfig)
 		json.Unmarshal(b, config)
 		d.Set("snow_user", config.User)
-		d.Set("snow_password", config.Password)
+		// snow_password is write-only, PagerDuty doesn't return it
 		d.Set("sync_options", config.SyncOptions)
 		d.Set("target", config.Target)
 		d.Set("task_type", config.TaskType)
@@ -213,7 +220,7 @@ func resourcePagerDutyExtensionServiceNowDelete(d *schema.ResourceData, meta int
 
 	if _, err := client.Extensions.Delete(d.Id()); err != nil {
 		if perr, ok := err.(*pagerduty.Error); ok && perr.Code == 5001 {
-			log.Printf("[WARN] Extension (%s) not found, removing from state", d.Id())
+			log.Printf("[DEBUG] Extension (%s) not found", d.Id())
 			return nil
 		}
 		return err
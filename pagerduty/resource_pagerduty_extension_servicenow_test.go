@@ -73,7 +73,7 @@ func TestAccPagerDutyExtensionServiceNow_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"pagerduty_extension_servicenow.foo", "snow_user", "meeps"),
 					resource.TestCheckResourceAttr(
-						"pagerduty_extension_servicenow.foo", "snow_password", "zorz"),
+						"pagerduty_extension_servicenow.foo", "snow_password", hashSensitiveValue("zorz")),
 					resource.TestCheckResourceAttr(
 						"pagerduty_extension_servicenow.foo", "sync_options", "manual_sync"),
 					resource.TestCheckResourceAttr(
@@ -99,7 +99,7 @@ func TestAccPagerDutyExtensionServiceNow_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"pagerduty_extension_servicenow.foo", "snow_user", "meeps"),
 					resource.TestCheckResourceAttr(
-						"pagerduty_extension_servicenow.foo", "snow_password", "zorz"),
+						"pagerduty_extension_servicenow.foo", "snow_password", hashSensitiveValue("zorz")),
 					resource.TestCheckResourceAttr(
 						"pagerduty_extension_servicenow.foo", "sync_options", "manual_sync"),
 					resource.TestCheckResourceAttr(
@@ -3,6 +3,8 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -92,6 +94,98 @@ func TestAccPagerDutyExtension_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyExtension_InvalidConfig(t *testing.T) {
+	name := resource.PrefixedUniqueId("tf-")
+	extension_name := resource.PrefixedUniqueId("tf-")
+	url := "https://example.com/receive_a_pagerduty_webhook"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyExtensionConfig(name, extension_name, url, "false", "everyone"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`config.restrict of a Generic V2 Webhook extension must be one of`),
+			},
+			{
+				Config:      testAccCheckPagerDutyExtensionConfig(name, extension_name, url, `"false"`, "any"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`config.notify_types.resolve of a Generic V2 Webhook extension must be a boolean`),
+			},
+		},
+	})
+}
+
+func TestPruneExtensionConfigKeys(t *testing.T) {
+	config := map[string]interface{}{
+		"restrict": "pd-users",
+		"referer":  "https://example.com",
+		"notify_types": map[string]interface{}{
+			"resolve":     false,
+			"acknowledge": true,
+		},
+	}
+
+	cases := []struct {
+		known    []interface{}
+		expected interface{}
+	}{
+		{nil, config},
+		// Added by PagerDuty, such as the referer, and not in the configuration
+		{
+			[]interface{}{map[string]interface{}{
+				"restrict":     "any",
+				"notify_types": map[string]interface{}{"resolve": true},
+			}},
+			map[string]interface{}{
+				"restrict":     "pd-users",
+				"notify_types": map[string]interface{}{"resolve": false},
+			},
+		},
+		// Kept when either in the prior state or in the configuration
+		{
+			[]interface{}{
+				map[string]interface{}{"restrict": "any"},
+				map[string]interface{}{"referer": "https://example.com"},
+			},
+			map[string]interface{}{
+				"restrict": "pd-users",
+				"referer":  "https://example.com",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		if got := pruneExtensionConfigKeys(config, c.known...); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("expected the config pruned with %v to be %v, got %v", c.known, c.expected, got)
+		}
+	}
+}
+
+func TestValidateExtensionConfigFields(t *testing.T) {
+	fields := knownExtensionConfigs["Generic V2 Webhook"]
+
+	cases := []struct {
+		config   map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"restrict": "any"}, ""},
+		// Fields added by newer versions of the extension schema
+		{map[string]interface{}{"restrict": "any", "new_field": 1}, ""},
+		{map[string]interface{}{"restrict": "everyone"}, `config.restrict of a Generic V2 Webhook extension must be one of [any pd-users], got: "everyone"`},
+		{map[string]interface{}{"notify_types": map[string]interface{}{"resolve": "false"}}, "config.notify_types.resolve of a Generic V2 Webhook extension must be a boolean"},
+	}
+
+	for _, c := range cases {
+		err := validateExtensionConfigFields("Generic V2 Webhook", "config", c.config, fields)
+		if got := fmt.Sprint(err); (err == nil && c.expected != "") || (err != nil && got != c.expected) {
+			t.Errorf("expected %v to be validated with %q, got %v", c.config, c.expected, err)
+		}
+	}
+}
+
 func testAccCheckPagerDutyExtensionDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -0,0 +1,291 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+type PagerDutyExtensionZendeskConfig struct {
+	Subdomain     string                   `json:"zendesk_subdomain"`
+	User          string                   `json:"zendesk_user"`
+	APIToken      string                   `json:"zendesk_api_token,omitempty"`
+	SyncDirection string                   `json:"sync_direction"`
+	FieldMappings []*extensionFieldMapping `json:"field_mappings"`
+}
+
+// extensionFieldMapping maps a PagerDuty incident field to a field of the
+// ticket or case of a ticketing extension.
+type extensionFieldMapping struct {
+	PagerDutyField string `json:"pagerduty_field"`
+	ExternalField  string `json:"external_field"`
+}
+
+// extensionFieldMappingSchema is shared by the typed extensions of ticketing
+// systems.
+func extensionFieldMappingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"pagerduty_field": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"external_field": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func expandExtensionFieldMappings(v interface{}) []*extensionFieldMapping {
+	mappings := []*extensionFieldMapping{}
+	for _, m := range v.([]interface{}) {
+		mapping := m.(map[string]interface{})
+		mappings = append(mappings, &extensionFieldMapping{
+			PagerDutyField: mapping["pagerduty_field"].(string),
+			ExternalField:  mapping["external_field"].(string),
+		})
+	}
+
+	return mappings
+}
+
+func flattenExtensionFieldMappings(mappings []*extensionFieldMapping) []interface{} {
+	var result []interface{}
+	for _, m := range mappings {
+		result = append(result, map[string]interface{}{
+			"pagerduty_field": m.PagerDutyField,
+			"external_field":  m.ExternalField,
+		})
+	}
+
+	return result
+}
+
+func resourcePagerDutyExtensionZendesk() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyExtensionZendeskCreate,
+		Read:   resourcePagerDutyExtensionZendeskRead,
+		Update: resourcePagerDutyExtensionZendeskUpdate,
+		Delete: resourcePagerDutyExtensionZendeskDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyExtensionZendeskImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"summary": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_url": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"extension_objects": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"extension_schema": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+			"zendesk_subdomain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zendesk_user": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zendesk_api_token": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				StateFunc: hashSensitiveValue,
+			},
+			"sync_direction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "two_way",
+				ValidateFunc: validateValueFunc([]string{
+					"one_way",
+					"two_way",
+				}),
+			},
+			"field_mapping": extensionFieldMappingSchema(),
+		},
+	}
+}
+
+func buildExtensionZendeskStruct(d *schema.ResourceData) *pagerduty.Extension {
+	extension := &pagerduty.Extension{
+		Name:        d.Get("name").(string),
+		Type:        "extension",
+		EndpointURL: d.Get("endpoint_url").(string),
+		ExtensionSchema: &pagerduty.ExtensionSchemaReference{
+			Type: "extension_schema_reference",
+			ID:   d.Get("extension_schema").(string),
+		},
+		ExtensionObjects: expandServiceObjects(d.Get("extension_objects")),
+	}
+
+	config := &PagerDutyExtensionZendeskConfig{
+		Subdomain:     d.Get("zendesk_subdomain").(string),
+		User:          d.Get("zendesk_user").(string),
+		SyncDirection: d.Get("sync_direction").(string),
+		FieldMappings: expandExtensionFieldMappings(d.Get("field_mapping")),
+	}
+
+	// The state only keeps a hash of the API token, so it's only sent when it
+	// has been set or changed in the configuration.
+	if d.HasChange("zendesk_api_token") {
+		config.APIToken = d.Get("zendesk_api_token").(string)
+	}
+	extension.Config = config
+
+	return extension
+}
+
+func fetchPagerDutyExtensionZendesk(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		extension, _, err := client.Extensions.Get(d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("summary", extension.Summary)
+		d.Set("name", extension.Name)
+		d.Set("endpoint_url", extension.EndpointURL)
+		d.Set("html_url", extension.HTMLURL)
+		if err := d.Set("extension_objects", flattenExtensionObjects(extension.ExtensionObjects)); err != nil {
+			log.Printf("[WARN] error setting extension_objects: %s", err)
+		}
+		d.Set("extension_schema", extension.ExtensionSchema.ID)
+
+		b, _ := json.Marshal(extension.Config)
+		config := new(PagerDutyExtensionZendeskConfig)
+		json.Unmarshal(b, config)
+		d.Set("zendesk_subdomain", config.Subdomain)
+		d.Set("zendesk_user", config.User)
+		// zendesk_api_token is write-only, PagerDuty doesn't return it
+		d.Set("sync_direction", config.SyncDirection)
+		if err := d.Set("field_mapping", flattenExtensionFieldMappings(config.FieldMappings)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyExtensionZendeskCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	extension := buildExtensionZendeskStruct(d)
+
+	log.Printf("[INFO] Creating PagerDuty Zendesk extension %s", extension.Name)
+
+	extension, _, err = client.Extensions.Create(extension)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(extension.ID)
+
+	return fetchPagerDutyExtensionZendesk(d, meta, genError)
+}
+
+func resourcePagerDutyExtensionZendeskRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty Zendesk extension %s", d.Id())
+	return fetchPagerDutyExtensionZendesk(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyExtensionZendeskUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	extension := buildExtensionZendeskStruct(d)
+
+	log.Printf("[INFO] Updating PagerDuty Zendesk extension %s", d.Id())
+
+	if _, _, err := client.Extensions.Update(d.Id(), extension); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyExtensionZendeskRead(d, meta)
+}
+
+func resourcePagerDutyExtensionZendeskDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting PagerDuty Zendesk extension %s", d.Id())
+
+	if _, err := client.Extensions.Delete(d.Id()); err != nil {
+		if perr, ok := err.(*pagerduty.Error); ok && perr.Code == 5001 {
+			log.Printf("[DEBUG] Extension (%s) not found", d.Id())
+			return nil
+		}
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyExtensionZendeskImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	extension, _, err := client.Extensions.Get(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, fmt.Errorf("error importing pagerduty_extension_zendesk. Expecting an importation ID for extension")
+	}
+
+	d.Set("extension_objects", flattenExtensionObjects(extension.ExtensionObjects))
+	d.Set("extension_schema", extension.ExtensionSchema.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,134 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyExtensionZendesk_Basic(t *testing.T) {
+	extension_name := resource.PrefixedUniqueId("tf-")
+	extension_name_updated := resource.PrefixedUniqueId("tf-")
+	name := resource.PrefixedUniqueId("tf-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyExtensionZendeskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyExtensionZendeskConfig(name, extension_name, "two_way"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyExtensionZendeskExists("pagerduty_extension_zendesk.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_zendesk.foo", "name", extension_name),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_zendesk.foo", "zendesk_api_token", hashSensitiveValue("zorz")),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_zendesk.foo", "sync_direction", "two_way"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_zendesk.foo", "field_mapping.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyExtensionZendeskConfig(name, extension_name_updated, "one_way"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyExtensionZendeskExists("pagerduty_extension_zendesk.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_zendesk.foo", "name", extension_name_updated),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_zendesk.foo", "sync_direction", "one_way"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyExtensionZendeskDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_extension_zendesk" {
+			continue
+		}
+
+		if _, _, err := client.Extensions.Get(r.Primary.ID); err == nil {
+			return fmt.Errorf("Extension still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyExtensionZendeskExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No extension ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.Extensions.Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Extension not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyExtensionZendeskConfig(name, extension_name, sync_direction string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s"
+  email = "%[1]s@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[1]s"
+  num_loops = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+data "pagerduty_extension_schema" "foo" {
+  name = "%[2]s"
+}
+
+resource "pagerduty_extension_zendesk" "foo" {
+  name              = "%[3]s"
+  extension_schema  = data.pagerduty_extension_schema.foo.id
+  extension_objects = [pagerduty_service.foo.id]
+  zendesk_subdomain = "acme"
+  zendesk_user      = "pagerduty@example.com"
+  zendesk_api_token = "zorz"
+  sync_direction    = "%[4]s"
+
+  field_mapping {
+    pagerduty_field = "incident_title"
+    external_field  = "subject"
+  }
+}
+`, name, "Zendesk", extension_name, sync_direction)
+}
@@ -0,0 +1,347 @@
+package pagerduty
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+type incidentReference struct {
+	ID      string `json:"id"`
+	Summary string `json:"summary,omitempty"`
+}
+
+type incidentAssignment struct {
+	Assignee *incidentReference `json:"assignee"`
+}
+
+type incident struct {
+	ID               string                `json:"id"`
+	IncidentNumber   int                   `json:"incident_number"`
+	Title            string                `json:"title"`
+	Status           string                `json:"status"`
+	Urgency          string                `json:"urgency"`
+	IncidentKey      string                `json:"incident_key"`
+	CreatedAt        string                `json:"created_at"`
+	HTMLURL          string                `json:"html_url"`
+	Service          *incidentReference    `json:"service"`
+	EscalationPolicy *incidentReference    `json:"escalation_policy"`
+	Priority         *incidentReference    `json:"priority"`
+	Assignments      []*incidentAssignment `json:"assignments"`
+}
+
+type incidentPayload struct {
+	Incident *incident `json:"incident"`
+}
+
+// Incidents can't be deleted: destroying a pagerduty_incident resolves it.
+// It is meant for incidents created on purpose, such as game days or paging
+// tests, not for managing the incidents raised by monitoring.
+func resourcePagerDutyIncident() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyIncidentCreate,
+		Read:   resourcePagerDutyIncidentRead,
+		Update: resourcePagerDutyIncidentUpdate,
+		Delete: resourcePagerDutyIncidentDelete,
+		CustomizeDiff: validateReferences(
+			referenceAttribute{key: "escalation_policy", kind: "escalation_policy"},
+			referenceAttribute{key: "priority", kind: "priority"},
+			referenceAttribute{key: "assignees", kind: "user"},
+		),
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyIncidentImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEmail,
+			},
+			"urgency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validateValueFunc([]string{
+					"high",
+					"low",
+				}),
+			},
+			"priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"incident_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"escalation_policy": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"assignees"},
+			},
+			"assignees": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"escalation_policy"},
+			},
+			"resolution": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"incident_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildIncidentBody builds the body creating or updating an incident, with
+// only the attributes set, or changed when updating.
+func buildIncidentBody(d *schema.ResourceData) map[string]interface{} {
+	i := map[string]interface{}{
+		"type": "incident",
+	}
+
+	creating := d.IsNewResource() || d.Id() == ""
+	changed := func(key string) bool {
+		return creating || d.HasChange(key)
+	}
+
+	if changed("title") {
+		i["title"] = d.Get("title").(string)
+	}
+	if v, ok := d.GetOk("urgency"); ok && changed("urgency") {
+		i["urgency"] = v.(string)
+	}
+	if changed("priority") {
+		if v, ok := d.GetOk("priority"); ok {
+			i["priority"] = map[string]interface{}{"id": v.(string), "type": "priority_reference"}
+		} else if !creating {
+			i["priority"] = nil
+		}
+	}
+	if v, ok := d.GetOk("escalation_policy"); ok && changed("escalation_policy") {
+		i["escalation_policy"] = map[string]interface{}{"id": v.(string), "type": "escalation_policy_reference"}
+	}
+	if v, ok := d.GetOk("assignees"); ok && changed("assignees") {
+		var assignments []map[string]interface{}
+		for _, id := range expandStringList(v.([]interface{})) {
+			assignments = append(assignments, map[string]interface{}{
+				"assignee": map[string]interface{}{"id": id, "type": "user_reference"},
+			})
+		}
+		i["assignments"] = assignments
+	}
+
+	if creating {
+		i["service"] = map[string]interface{}{"id": d.Get("service").(string), "type": "service_reference"}
+		if v, ok := d.GetOk("body"); ok {
+			i["body"] = map[string]interface{}{"type": "incident_body", "details": v.(string)}
+		}
+		if v, ok := d.GetOk("incident_key"); ok {
+			i["incident_key"] = v.(string)
+		}
+	}
+
+	return map[string]interface{}{"incident": i}
+}
+
+// incidentRequest sends a request to the incidents API on behalf of the user
+// with the email in from, as the API requires for changes.
+func incidentRequest(client *pagerduty.Client, method, path, from string, body, v interface{}) error {
+	headers := http.Header{}
+	headers.Set("From", from)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, err := apiRequestWithHeaders(client, method, path, nil, headers, body, v); err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func resourcePagerDutyIncidentCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating PagerDuty incident on service %s", d.Get("service").(string))
+
+	resp := new(incidentPayload)
+	if err := incidentRequest(client, "POST", "/incidents", d.Get("from").(string), buildIncidentBody(d), resp); err != nil {
+		return err
+	}
+
+	d.SetId(resp.Incident.ID)
+
+	// A 404 right after the incident is created is retried, as dropping it
+	// from the state would page again on the next apply.
+	return fetchPagerDutyIncident(d, meta, genError)
+}
+
+func resourcePagerDutyIncidentRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty incident %s", d.Id())
+	return fetchPagerDutyIncident(d, meta, handleNotFoundError)
+}
+
+func fetchPagerDutyIncident(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		resp := new(incidentPayload)
+		if _, err := apiRequest(client, "GET", "/incidents/"+d.Id(), nil, nil, resp); err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		flattenIncident(d, resp.Incident)
+
+		return nil
+	})
+}
+
+// flattenIncident keeps incidents resolved outside of Terraform in the state,
+// so that the next apply doesn't page anyone again by recreating them.
+func flattenIncident(d *schema.ResourceData, i *incident) {
+	d.Set("title", i.Title)
+	d.Set("urgency", i.Urgency)
+	d.Set("incident_key", i.IncidentKey)
+	d.Set("incident_number", i.IncidentNumber)
+	d.Set("status", i.Status)
+	d.Set("created_at", i.CreatedAt)
+	d.Set("html_url", i.HTMLURL)
+
+	if i.Service != nil {
+		d.Set("service", i.Service.ID)
+	}
+	if i.EscalationPolicy != nil {
+		d.Set("escalation_policy", i.EscalationPolicy.ID)
+	}
+
+	priority := ""
+	if i.Priority != nil {
+		priority = i.Priority.ID
+	}
+	d.Set("priority", priority)
+
+	// Resolved incidents have no assignees left
+	if i.Status != "resolved" {
+		var assignees []string
+		for _, a := range i.Assignments {
+			if a.Assignee != nil {
+				assignees = append(assignees, a.Assignee.ID)
+			}
+		}
+		d.Set("assignees", assignees)
+	}
+}
+
+func resourcePagerDutyIncidentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	changes := []string{"title", "urgency", "priority", "escalation_policy", "assignees"}
+	if d.HasChanges(changes...) {
+		log.Printf("[INFO] Updating PagerDuty incident %s", d.Id())
+
+		if err := incidentRequest(client, "PUT", "/incidents/"+d.Id(), d.Get("from").(string), buildIncidentBody(d), nil); err != nil {
+			return err
+		}
+	}
+
+	return resourcePagerDutyIncidentRead(d, meta)
+}
+
+func resourcePagerDutyIncidentDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	if d.Get("status").(string) != "resolved" {
+		log.Printf("[INFO] Resolving PagerDuty incident %s", d.Id())
+
+		i := map[string]interface{}{
+			"type":   "incident",
+			"status": "resolved",
+		}
+		if v, ok := d.GetOk("resolution"); ok {
+			i["resolution"] = v.(string)
+		}
+
+		err := incidentRequest(client, "PUT", "/incidents/"+d.Id(), d.Get("from").(string), map[string]interface{}{"incident": i}, nil)
+		if err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourcePagerDutyIncidentImport needs the from email along with the ID, as
+// it's required to resolve the incident when it's destroyed.
+func resourcePagerDutyIncidentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseCompositeImportIDWithTail("pagerduty_incident", d.Id(), "incident_id", "from_email")
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(ids[0])
+	d.Set("from", ids[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,125 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyIncident_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	title := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	titleUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentConfig(username, email, escalationPolicy, service, title, "high"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentExists("pagerduty_incident.foo"),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "title", title),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "urgency", "high"),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "status", "triggered"),
+					resource.TestCheckResourceAttrPair("pagerduty_incident.foo", "assignees.0", "pagerduty_user.foo", "id"),
+					resource.TestCheckResourceAttrSet("pagerduty_incident.foo", "incident_number"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentConfig(username, email, escalationPolicy, service, titleUpdated, "low"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentExists("pagerduty_incident.foo"),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "title", titleUpdated),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "urgency", "low"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident" {
+			continue
+		}
+
+		// Incidents can't be deleted, only resolved
+		resp := new(incidentPayload)
+		if _, err := apiRequest(client, "GET", "/incidents/"+r.Primary.ID, nil, nil, resp); err == nil && resp.Incident.Status != "resolved" {
+			return fmt.Errorf("Incident %s is still %s", r.Primary.ID, resp.Incident.Status)
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No incident ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		resp := new(incidentPayload)
+		if _, err := apiRequest(client, "GET", "/incidents/"+rs.Primary.ID, nil, nil, resp); err != nil {
+			return err
+		}
+
+		if resp.Incident.ID != rs.Primary.ID {
+			return fmt.Errorf("Incident not found: %v - %v", rs.Primary.ID, resp.Incident)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyIncidentConfig(username, email, escalationPolicy, service, title, urgency string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_incident" "foo" {
+  service    = pagerduty_service.foo.id
+  title      = "%s"
+  urgency    = "%s"
+  from       = pagerduty_user.foo.email
+  assignees  = [pagerduty_user.foo.id]
+  resolution = "Resolved by Terraform"
+}
+`, username, email, escalationPolicy, service, title, urgency)
+}
@@ -0,0 +1,477 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// jiraCloudReference represents a Jira project, issue type or status.
+type jiraCloudReference struct {
+	ID   string `json:"id,omitempty"`
+	Key  string `json:"key,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type jiraCloudStatusMapping struct {
+	Triggered    *jiraCloudReference `json:"triggered,omitempty"`
+	Acknowledged *jiraCloudReference `json:"acknowledged,omitempty"`
+	Resolved     *jiraCloudReference `json:"resolved,omitempty"`
+}
+
+type jiraCloudPriority struct {
+	PagerDutyID string `json:"pagerduty_id"`
+	JiraID      string `json:"jira_id"`
+}
+
+type jiraCloudCustomField struct {
+	SourceIncidentField  string `json:"source_incident_field,omitempty"`
+	TargetIssueField     string `json:"target_issue_field"`
+	TargetIssueFieldName string `json:"target_issue_field_name"`
+	Type                 string `json:"type"`
+	Value                string `json:"value,omitempty"`
+}
+
+type jiraCloudSettings struct {
+	Project                      *jiraCloudReference     `json:"project,omitempty"`
+	IssueType                    *jiraCloudReference     `json:"issue_type,omitempty"`
+	CreateIssueOnIncidentTrigger bool                    `json:"create_issue_on_incident_trigger"`
+	SyncNotesUser                *jiraCloudObjectRef     `json:"sync_notes_user,omitempty"`
+	StatusMapping                *jiraCloudStatusMapping `json:"status_mapping,omitempty"`
+	Priorities                   []*jiraCloudPriority    `json:"priorities"`
+	CustomFields                 []*jiraCloudCustomField `json:"custom_fields"`
+}
+
+type jiraCloudObjectRef struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+type jiraCloudRuleConfig struct {
+	Service *jiraCloudObjectRef `json:"service,omitempty"`
+	Jira    *jiraCloudSettings  `json:"jira,omitempty"`
+}
+
+// jiraCloudRule represents a rule of a Jira Cloud account mapping, which
+// configures how the incidents of a service are synced with Jira issues.
+type jiraCloudRule struct {
+	ID     string               `json:"id,omitempty"`
+	Name   string               `json:"name,omitempty"`
+	Config *jiraCloudRuleConfig `json:"config,omitempty"`
+}
+
+func jiraCloudRulesPath(accountMappingID string) string {
+	return fmt.Sprintf("/integration-jira-cloud/accounts_mappings/%s/rules", accountMappingID)
+}
+
+func jiraCloudReferenceSchema(withKey bool) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+	if withKey {
+		s["key"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		}
+	}
+
+	return &schema.Resource{Schema: s}
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyJiraCloudAccountMappingRuleCreate,
+		Read:   resourcePagerDutyJiraCloudAccountMappingRuleRead,
+		Update: resourcePagerDutyJiraCloudAccountMappingRuleUpdate,
+		Delete: resourcePagerDutyJiraCloudAccountMappingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyJiraCloudAccountMappingRuleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"account_mapping": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     jiraCloudReferenceSchema(true),
+			},
+			"issue_type": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     jiraCloudReferenceSchema(false),
+			},
+			"create_issue_on_incident_trigger": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"sync_notes_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status_mapping": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"triggered": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     jiraCloudReferenceSchema(false),
+						},
+						"acknowledged": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     jiraCloudReferenceSchema(false),
+						},
+						"resolved": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     jiraCloudReferenceSchema(false),
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pagerduty_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"jira_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"custom_field": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateValueFunc([]string{
+								"attribute",
+								"const",
+								"jira_value",
+							}),
+						},
+						"source_incident_field": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"target_issue_field": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"target_issue_field_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandJiraCloudReference(v interface{}) *jiraCloudReference {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	ref := &jiraCloudReference{
+		ID:   m["id"].(string),
+		Name: m["name"].(string),
+	}
+	if key, ok := m["key"]; ok {
+		ref.Key = key.(string)
+	}
+
+	return ref
+}
+
+func flattenJiraCloudReference(ref *jiraCloudReference, withKey bool) []interface{} {
+	if ref == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"id":   ref.ID,
+		"name": ref.Name,
+	}
+	if withKey {
+		m["key"] = ref.Key
+	}
+
+	return []interface{}{m}
+}
+
+func buildJiraCloudRuleStruct(d *schema.ResourceData) *jiraCloudRule {
+	jira := &jiraCloudSettings{
+		Project:                      expandJiraCloudReference(d.Get("project")),
+		IssueType:                    expandJiraCloudReference(d.Get("issue_type")),
+		CreateIssueOnIncidentTrigger: d.Get("create_issue_on_incident_trigger").(bool),
+		Priorities:                   []*jiraCloudPriority{},
+		CustomFields:                 []*jiraCloudCustomField{},
+	}
+
+	if v := d.Get("sync_notes_user").(string); v != "" {
+		jira.SyncNotesUser = &jiraCloudObjectRef{ID: v, Type: "user_reference"}
+	}
+
+	if l := d.Get("status_mapping").([]interface{}); len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+		jira.StatusMapping = &jiraCloudStatusMapping{
+			Triggered:    expandJiraCloudReference(m["triggered"]),
+			Acknowledged: expandJiraCloudReference(m["acknowledged"]),
+			Resolved:     expandJiraCloudReference(m["resolved"]),
+		}
+	}
+
+	for _, p := range d.Get("priority").([]interface{}) {
+		m := p.(map[string]interface{})
+		jira.Priorities = append(jira.Priorities, &jiraCloudPriority{
+			PagerDutyID: m["pagerduty_id"].(string),
+			JiraID:      m["jira_id"].(string),
+		})
+	}
+
+	for _, f := range d.Get("custom_field").([]interface{}) {
+		m := f.(map[string]interface{})
+		jira.CustomFields = append(jira.CustomFields, &jiraCloudCustomField{
+			Type:                 m["type"].(string),
+			SourceIncidentField:  m["source_incident_field"].(string),
+			TargetIssueField:     m["target_issue_field"].(string),
+			TargetIssueFieldName: m["target_issue_field_name"].(string),
+			Value:                m["value"].(string),
+		})
+	}
+
+	return &jiraCloudRule{
+		Name: d.Get("name").(string),
+		Config: &jiraCloudRuleConfig{
+			Service: &jiraCloudObjectRef{ID: d.Get("service").(string), Type: "service_reference"},
+			Jira:    jira,
+		},
+	}
+}
+
+func flattenJiraCloudRule(d *schema.ResourceData, rule *jiraCloudRule) error {
+	d.Set("name", rule.Name)
+
+	if rule.Config == nil {
+		return nil
+	}
+
+	if rule.Config.Service != nil {
+		d.Set("service", rule.Config.Service.ID)
+	}
+
+	jira := rule.Config.Jira
+	if jira == nil {
+		return nil
+	}
+
+	d.Set("create_issue_on_incident_trigger", jira.CreateIssueOnIncidentTrigger)
+
+	if jira.SyncNotesUser != nil {
+		d.Set("sync_notes_user", jira.SyncNotesUser.ID)
+	} else {
+		d.Set("sync_notes_user", "")
+	}
+
+	if err := d.Set("project", flattenJiraCloudReference(jira.Project, true)); err != nil {
+		return err
+	}
+	if err := d.Set("issue_type", flattenJiraCloudReference(jira.IssueType, false)); err != nil {
+		return err
+	}
+
+	var statusMapping []interface{}
+	if sm := jira.StatusMapping; sm != nil {
+		statusMapping = append(statusMapping, map[string]interface{}{
+			"triggered":    flattenJiraCloudReference(sm.Triggered, false),
+			"acknowledged": flattenJiraCloudReference(sm.Acknowledged, false),
+			"resolved":     flattenJiraCloudReference(sm.Resolved, false),
+		})
+	}
+	if err := d.Set("status_mapping", statusMapping); err != nil {
+		return err
+	}
+
+	var priorities []interface{}
+	for _, p := range jira.Priorities {
+		priorities = append(priorities, map[string]interface{}{
+			"pagerduty_id": p.PagerDutyID,
+			"jira_id":      p.JiraID,
+		})
+	}
+	if err := d.Set("priority", priorities); err != nil {
+		return err
+	}
+
+	var customFields []interface{}
+	for _, f := range jira.CustomFields {
+		customFields = append(customFields, map[string]interface{}{
+			"type":                    f.Type,
+			"source_incident_field":   f.SourceIncidentField,
+			"target_issue_field":      f.TargetIssueField,
+			"target_issue_field_name": f.TargetIssueFieldName,
+			"value":                   f.Value,
+		})
+	}
+
+	return d.Set("custom_field", customFields)
+}
+
+func fetchPagerDutyJiraCloudAccountMappingRule(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	accountMappingID := d.Get("account_mapping").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		rule := new(jiraCloudRule)
+		if _, err := apiRequest(client, "GET", jiraCloudRulesPath(accountMappingID)+"/"+d.Id(), nil, nil, rule); err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if err := flattenJiraCloudRule(d, rule); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	accountMappingID := d.Get("account_mapping").(string)
+
+	log.Printf("[INFO] Creating PagerDuty Jira Cloud rule %s in account mapping %s", d.Get("name").(string), accountMappingID)
+
+	rule := new(jiraCloudRule)
+	if _, err := apiRequest(client, "POST", jiraCloudRulesPath(accountMappingID), nil, buildJiraCloudRuleStruct(d), rule); err != nil {
+		return err
+	}
+
+	d.SetId(rule.ID)
+
+	return fetchPagerDutyJiraCloudAccountMappingRule(d, meta, genError)
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRuleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty Jira Cloud rule %s", d.Id())
+
+	return fetchPagerDutyJiraCloudAccountMappingRule(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating PagerDuty Jira Cloud rule %s", d.Id())
+
+	accountMappingID := d.Get("account_mapping").(string)
+
+	if _, err := apiRequest(client, "PUT", jiraCloudRulesPath(accountMappingID)+"/"+d.Id(), nil, buildJiraCloudRuleStruct(d), nil); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyJiraCloudAccountMappingRuleRead(d, meta)
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting PagerDuty Jira Cloud rule %s", d.Id())
+
+	accountMappingID := d.Get("account_mapping").(string)
+
+	if _, err := apiRequest(client, "DELETE", jiraCloudRulesPath(accountMappingID)+"/"+d.Id(), nil, nil, nil); err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids, err := parseCompositeImportID("pagerduty_jira_cloud_account_mapping_rule", d.Id(), "account_mapping_id", "rule_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	accountMappingID, id := ids[0], ids[1]
+
+	if _, err := apiRequest(client, "GET", jiraCloudRulesPath(accountMappingID)+"/"+id, nil, nil, nil); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(id)
+	d.Set("account_mapping", accountMappingID)
+
+	return []*schema.ResourceData{d}, nil
+}
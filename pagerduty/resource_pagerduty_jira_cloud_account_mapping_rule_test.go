@@ -0,0 +1,165 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	/* The Jira project and issue type must exist in the Jira Cloud account
+	mapped to the PagerDuty account running these tests. The PagerDuty subdomain
+	is taken from the PAGERDUTY_ACC_JIRA_CLOUD_SUBDOMAIN environment variable */
+	jiraCloudProjectID   string = "10000"
+	jiraCloudProjectKey  string = "PD"
+	jiraCloudIssueTypeID string = "10001"
+)
+
+func TestAccPagerDutyJiraCloudAccountMappingRule_Basic(t *testing.T) {
+	subdomain := os.Getenv("PAGERDUTY_ACC_JIRA_CLOUD_SUBDOMAIN")
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	rule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	ruleUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckJiraCloud(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyJiraCloudAccountMappingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyJiraCloudAccountMappingRuleConfig(subdomain, username, email, escalationPolicy, service, rule, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyJiraCloudAccountMappingRuleExists("pagerduty_jira_cloud_account_mapping_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_jira_cloud_account_mapping_rule.foo", "name", rule),
+					resource.TestCheckResourceAttr(
+						"pagerduty_jira_cloud_account_mapping_rule.foo", "project.0.key", jiraCloudProjectKey),
+					resource.TestCheckResourceAttr(
+						"pagerduty_jira_cloud_account_mapping_rule.foo", "create_issue_on_incident_trigger", "false"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_jira_cloud_account_mapping_rule.foo", "custom_field.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyJiraCloudAccountMappingRuleConfig(subdomain, username, email, escalationPolicy, service, ruleUpdated, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyJiraCloudAccountMappingRuleExists("pagerduty_jira_cloud_account_mapping_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_jira_cloud_account_mapping_rule.foo", "name", ruleUpdated),
+					resource.TestCheckResourceAttr(
+						"pagerduty_jira_cloud_account_mapping_rule.foo", "create_issue_on_incident_trigger", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyJiraCloudAccountMappingRuleDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_jira_cloud_account_mapping_rule" {
+			continue
+		}
+
+		path := jiraCloudRulesPath(r.Primary.Attributes["account_mapping"]) + "/" + r.Primary.ID
+		if _, err := apiRequest(client, "GET", path, nil, nil, nil); err == nil {
+			return fmt.Errorf("Jira Cloud rule still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyJiraCloudAccountMappingRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Jira Cloud rule ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found := new(jiraCloudRule)
+		path := jiraCloudRulesPath(rs.Primary.Attributes["account_mapping"]) + "/" + rs.Primary.ID
+		if _, err := apiRequest(client, "GET", path, nil, nil, found); err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Jira Cloud rule not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyJiraCloudAccountMappingRuleConfig(subdomain, username, email, escalationPolicy, service, rule string, autocreate bool) string {
+	return fmt.Sprintf(`
+data "pagerduty_jira_cloud_account_mapping" "foo" {
+  subdomain = "%s"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_jira_cloud_account_mapping_rule" "foo" {
+  account_mapping = data.pagerduty_jira_cloud_account_mapping.foo.id
+  name            = "%s"
+  service         = pagerduty_service.foo.id
+
+  project {
+    id   = "%s"
+    key  = "%s"
+    name = "PagerDuty"
+  }
+
+  issue_type {
+    id   = "%s"
+    name = "Task"
+  }
+
+  create_issue_on_incident_trigger = %t
+  sync_notes_user                  = pagerduty_user.foo.id
+
+  custom_field {
+    type                    = "attribute"
+    source_incident_field   = "incident_number"
+    target_issue_field      = "summary"
+    target_issue_field_name = "Summary"
+  }
+}
+`, subdomain, username, email, escalationPolicy, service, rule, jiraCloudProjectID, jiraCloudProjectKey, jiraCloudIssueTypeID, autocreate)
+}
@@ -0,0 +1,269 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// msTeamsConnection represents a connection between a PagerDuty service or
+// team and a channel of the Microsoft Teams integration.
+type msTeamsConnection struct {
+	ID               string                     `json:"id,omitempty"`
+	SourceID         string                     `json:"source_id,omitempty"`
+	SourceName       string                     `json:"source_name,omitempty"`
+	SourceType       string                     `json:"source_type,omitempty"`
+	TenantID         string                     `json:"tenant_id,omitempty"`
+	TeamID           string                     `json:"team_id,omitempty"`
+	TeamName         string                     `json:"team_name,omitempty"`
+	ChannelID        string                     `json:"channel_id,omitempty"`
+	ChannelName      string                     `json:"channel_name,omitempty"`
+	NotificationType string                     `json:"notification_type,omitempty"`
+	Config           pagerduty.ConnectionConfig `json:"config,omitempty"`
+}
+
+type msTeamsConnectionPayload struct {
+	MSTeamsConnection *msTeamsConnection `json:"msteams_connection"`
+}
+
+func msTeamsConnectionsPath(tenantID string) string {
+	return fmt.Sprintf("/integration-msteams/tenants/%s/connections", tenantID)
+}
+
+func resourcePagerDutyMSTeamsConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyMSTeamsConnectionCreate,
+		Read:   resourcePagerDutyMSTeamsConnectionRead,
+		Update: resourcePagerDutyMSTeamsConnectionUpdate,
+		Delete: resourcePagerDutyMSTeamsConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyMSTeamsConnectionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"source_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validateValueFunc([]string{
+					"service_reference",
+					"team_reference",
+				}),
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSTEAMS_CONNECTION_TENANT_ID", nil),
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"team_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"channel_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validateValueFunc([]string{
+					"responder",
+					"stakeholder",
+				}),
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"events": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"priorities": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"urgency": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validateValueFunc([]string{
+								"high",
+								"low",
+							}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildMSTeamsConnectionStruct(d *schema.ResourceData) *msTeamsConnection {
+	return &msTeamsConnection{
+		SourceID:         d.Get("source_id").(string),
+		SourceType:       d.Get("source_type").(string),
+		TenantID:         d.Get("tenant_id").(string),
+		TeamID:           d.Get("team_id").(string),
+		ChannelID:        d.Get("channel_id").(string),
+		NotificationType: d.Get("notification_type").(string),
+		Config:           expandConnectionConfig(d.Get("config").(interface{})),
+	}
+}
+
+func resourcePagerDutyMSTeamsConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	conn := buildMSTeamsConnectionStruct(d)
+
+	log.Printf("[INFO] Creating PagerDuty Microsoft Teams connection for source %s and channel %s", conn.SourceID, conn.ChannelID)
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		v := new(msTeamsConnectionPayload)
+		if _, err := apiRequest(client, "POST", msTeamsConnectionsPath(conn.TenantID), nil, &msTeamsConnectionPayload{MSTeamsConnection: conn}, v); err != nil {
+			if isErrCode(err, 400) {
+				return resource.NonRetryableError(err)
+			}
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		} else if v.MSTeamsConnection != nil {
+			d.SetId(v.MSTeamsConnection.ID)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return resourcePagerDutyMSTeamsConnectionRead(d, meta)
+}
+
+func resourcePagerDutyMSTeamsConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	tenantID := d.Get("tenant_id").(string)
+
+	log.Printf("[INFO] Reading PagerDuty Microsoft Teams connection %s in tenant %s", d.Id(), tenantID)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		v := new(msTeamsConnectionPayload)
+		if _, err := apiRequest(client, "GET", fmt.Sprintf("%s/%s", msTeamsConnectionsPath(tenantID), d.Id()), nil, nil, v); err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		conn := v.MSTeamsConnection
+		if conn == nil {
+			return nil
+		}
+
+		d.Set("source_id", conn.SourceID)
+		d.Set("source_name", conn.SourceName)
+		d.Set("source_type", conn.SourceType)
+		d.Set("team_id", conn.TeamID)
+		d.Set("team_name", conn.TeamName)
+		d.Set("channel_id", conn.ChannelID)
+		d.Set("channel_name", conn.ChannelName)
+		d.Set("notification_type", conn.NotificationType)
+		d.Set("config", flattenConnectionConfig(conn.Config))
+
+		return nil
+	})
+}
+
+func resourcePagerDutyMSTeamsConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	conn := buildMSTeamsConnectionStruct(d)
+
+	log.Printf("[INFO] Updating PagerDuty Microsoft Teams connection %s", d.Id())
+
+	if _, err := apiRequest(client, "PUT", fmt.Sprintf("%s/%s", msTeamsConnectionsPath(conn.TenantID), d.Id()), nil, &msTeamsConnectionPayload{MSTeamsConnection: conn}, nil); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyMSTeamsConnectionRead(d, meta)
+}
+
+func resourcePagerDutyMSTeamsConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	tenantID := d.Get("tenant_id").(string)
+
+	log.Printf("[INFO] Deleting PagerDuty Microsoft Teams connection %s", d.Id())
+
+	if _, err := apiRequest(client, "DELETE", fmt.Sprintf("%s/%s", msTeamsConnectionsPath(tenantID), d.Id()), nil, nil, nil); err != nil {
+		if !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyMSTeamsConnectionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := parseCompositeImportID("pagerduty_msteams_connection", d.Id(), "tenant_id", "msteams_connection_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	tenantID, connectionID := ids[0], ids[1]
+
+	if _, err := apiRequest(client, "GET", fmt.Sprintf("%s/%s", msTeamsConnectionsPath(tenantID), connectionID), nil, nil, nil); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(connectionID)
+	d.Set("tenant_id", tenantID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,132 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	/* msTeamsTeamID and msTeamsChannelID must be valid IDs from a Microsoft Teams
+	tenant connected to the PagerDuty account running these tests. The tenant ID is
+	taken from the MSTEAMS_CONNECTION_TENANT_ID environment variable */
+	msTeamsTeamID    string = "19:1c7b2d5e3f6a4b8c9d0e1f2a3b4c5d6e@thread.tacv2"
+	msTeamsChannelID string = "19:9f8e7d6c5b4a39281706f5e4d3c2b1a0@thread.tacv2"
+)
+
+func TestAccPagerDutyMSTeamsConnection_Basic(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckMSTeamsConnection(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMSTeamsConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyMSTeamsConnectionConfig(team, "high"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMSTeamsConnectionExists("pagerduty_msteams_connection.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_msteams_connection.foo", "source_name", team),
+					resource.TestCheckResourceAttr(
+						"pagerduty_msteams_connection.foo", "config.0.events.#", "3"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_msteams_connection.foo", "config.0.urgency", "high"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyMSTeamsConnectionConfig(team, "low"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMSTeamsConnectionExists("pagerduty_msteams_connection.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_msteams_connection.foo", "config.0.urgency", "low"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckMSTeamsConnection(t *testing.T) {
+	if v := os.Getenv("MSTEAMS_CONNECTION_TENANT_ID"); v == "" {
+		t.Skip("MSTEAMS_CONNECTION_TENANT_ID not set. Skipping Microsoft Teams connection tests")
+	}
+}
+
+func testAccCheckPagerDutyMSTeamsConnectionDestroy(s *terraform.State) error {
+	client, err := testAccProvider.Meta().(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_msteams_connection" {
+			continue
+		}
+
+		path := fmt.Sprintf("%s/%s", msTeamsConnectionsPath(r.Primary.Attributes["tenant_id"]), r.Primary.ID)
+		if _, err := apiRequest(client, "GET", path, nil, nil, nil); err == nil {
+			return fmt.Errorf("Microsoft Teams connection still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyMSTeamsConnectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Microsoft Teams connection ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).SlackClient()
+		if err != nil {
+			return err
+		}
+
+		v := new(msTeamsConnectionPayload)
+		path := fmt.Sprintf("%s/%s", msTeamsConnectionsPath(rs.Primary.Attributes["tenant_id"]), rs.Primary.ID)
+		if _, err := apiRequest(client, "GET", path, nil, nil, v); err != nil {
+			return err
+		}
+
+		if v.MSTeamsConnection == nil || v.MSTeamsConnection.ID != rs.Primary.ID {
+			return fmt.Errorf("Microsoft Teams connection not found: %v - %v", rs.Primary.ID, v.MSTeamsConnection)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyMSTeamsConnectionConfig(team, urgency string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_msteams_connection" "foo" {
+  source_id         = pagerduty_team.foo.id
+  source_type       = "team_reference"
+  team_id           = "%s"
+  channel_id        = "%s"
+  notification_type = "responder"
+  config {
+    events = [
+      "incident.triggered",
+      "incident.acknowledged",
+      "incident.resolved"
+    ]
+    urgency = "%s"
+  }
+}
+`, team, msTeamsTeamID, msTeamsChannelID, urgency)
+}
@@ -1,22 +1,25 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"strings"
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func resourcePagerDutyResponsePlay() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyResponsePlayCreate,
-		Read:   resourcePagerDutyResponsePlayRead,
-		Update: resourcePagerDutyResponsePlayUpdate,
-		Delete: resourcePagerDutyResponsePlayDelete,
+		Create:        resourcePagerDutyResponsePlayCreate,
+		Read:          resourcePagerDutyResponsePlayRead,
+		Update:        resourcePagerDutyResponsePlayUpdate,
+		Delete:        resourcePagerDutyResponsePlayDelete,
+		CustomizeDiff: validateResponsePlayReferences,
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyResponsePlayImport,
 		},
@@ -36,8 +39,10 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 				Default:  "Managed by Terraform",
 			},
 			"from": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateEmail,
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 			"team": {
 				Type:     schema.TypeString,
@@ -49,12 +54,17 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateReferenceID,
 						},
 						"type": {
 							Type:     schema.TypeString,
 							Optional: true,
+							ValidateFunc: validateValueFunc([]string{
+								"user_reference",
+								"team_reference",
+							}),
 						},
 					},
 				},
@@ -69,12 +79,17 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateReferenceID,
 						},
 						"type": {
 							Type:     schema.TypeString,
 							Optional: true,
+							ValidateFunc: validateValueFunc([]string{
+								"user_reference",
+								"escalation_policy_reference",
+							}),
 						},
 						"name": {
 							Type:     schema.TypeString,
@@ -167,18 +182,54 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			// The conference bridge is often set up in the web UI, so it's
+			// only managed when configured.
 			"conference_number": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateConferenceNumber,
 			},
 			"conference_url": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 		},
 	}
 }
 
+// validateConferenceNumber accepts phone numbers followed by the pauses and
+// access codes dialed after the call is answered, e.g. +1 415-555-1212,,,,1234#
+var validateConferenceNumber = validation.StringMatch(
+	regexp.MustCompile(`^\+?[0-9][0-9 ().\-]*[0-9)]([,;]+[0-9*#]+)*[,;]*#?$`),
+	"must be a phone number, optionally followed by pauses (, or ;) and an access code",
+)
+
+// validateResponsePlayReferences checks at plan time that every subscriber and
+// responder references an object, as the API only rejects incomplete
+// references with a generic error at apply time.
+func validateResponsePlayReferences(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, block := range []string{"subscriber", "responder"} {
+		for i := 0; i < diff.Get(block+".#").(int); i++ {
+			key := fmt.Sprintf("%s.%d", block, i)
+			if !diff.NewValueKnown(key+".id") || !diff.NewValueKnown(key+".type") {
+				continue
+			}
+
+			if diff.Get(key+".id").(string) == "" {
+				return fmt.Errorf("%s: `id` is required", key)
+			}
+			if diff.Get(key+".type").(string) == "" {
+				return fmt.Errorf("%s: `type` is required", key)
+			}
+		}
+	}
+
+	return nil
+}
+
 func buildResponsePlayStruct(d *schema.ResourceData) *pagerduty.ResponsePlay {
 	responsePlay := &pagerduty.ResponsePlay{
 		Name:      d.Get("name").(string),
@@ -250,10 +301,10 @@ func resourcePagerDutyResponsePlayCreate(d *schema.ResourceData, meta interface{
 		time.Sleep(2 * time.Second)
 		return retryErr
 	}
-	return resourcePagerDutyResponsePlayRead(d, meta)
+	return fetchPagerDutyResponsePlay(d, meta, genError)
 }
 
-func resourcePagerDutyResponsePlayRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutyResponsePlay(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
@@ -264,8 +315,13 @@ func resourcePagerDutyResponsePlayRead(d *schema.ResourceData, meta interface{})
 
 	return resource.Retry(2*time.Minute, func() *resource.RetryError {
 		if responsePlay, _, err := client.ResponsePlays.Get(d.Id(), from); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if responsePlay != nil {
 			if responsePlay.Team != nil {
 				d.Set("team", []interface{}{responsePlay.Team})
@@ -293,6 +349,10 @@ func resourcePagerDutyResponsePlayRead(d *schema.ResourceData, meta interface{})
 	})
 }
 
+func resourcePagerDutyResponsePlayRead(d *schema.ResourceData, meta interface{}) error {
+	return fetchPagerDutyResponsePlay(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutyResponsePlayUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -478,10 +538,9 @@ func resourcePagerDutyResponsePlayImport(d *schema.ResourceData, meta interface{
 		return []*schema.ResourceData{}, err
 	}
 
-	ids := strings.SplitN(d.Id(), ".", 2)
-
-	if len(ids) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_response_play. Expecting an importation ID formed as '<response_play_id>.<from_email>'")
+	ids, err := parseCompositeImportIDWithTail("pagerduty_response_play", d.Id(), "response_play_id", "from_email")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 	rid, from := ids[0], ids[1]
 	log.Printf("[INFO] Importing PagerDuty response play: %s (From: %s)", rid, from)
@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -41,12 +42,59 @@ func TestAccPagerDutyResponsePlay_Basic(t *testing.T) {
 						"pagerduty_response_play.foo", "responder.#", "1"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_response_play.foo", "subscriber.#", "1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_response_play.foo", "conference_number", "+1 415-555-1212,,,,1234#"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_response_play.foo", "conference_url", "https://example.com/bridge"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccPagerDutyResponsePlay_InvalidReference(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyResponsePlayReferenceConfig("service_reference", "PXXXXXX"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is an invalid value for argument subscriber.0.type"),
+			},
+			{
+				Config:      testAccCheckPagerDutyResponsePlayReferenceConfig("user_reference", "foo"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not a valid PagerDuty ID"),
+			},
+			{
+				Config:      testAccCheckPagerDutyResponsePlayReferenceConfig("user_reference", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("subscriber.0: `id` is required"),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyResponsePlay_InvalidConference(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyResponsePlayConferenceConfig("call me", "https://example.com/bridge"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a phone number"),
+			},
+			{
+				Config:      testAccCheckPagerDutyResponsePlayConferenceConfig("+1 415-555-1212", "example.com/bridge"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expected \"conference_url\" to have a host"),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyResponsePlayDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -176,6 +224,32 @@ resource "pagerduty_response_play" "foo" {
 		id = pagerduty_user.foo.id
 	}
 	runnability = "services"
+	conference_number = "+1 415-555-1212,,,,1234#"
+	conference_url = "https://example.com/bridge"
 }
 `, name)
 }
+
+func testAccCheckPagerDutyResponsePlayReferenceConfig(subscriberType, subscriberID string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_response_play" "foo" {
+  name = "foo"
+  from = "foo@foo.test"
+  subscriber {
+    type = "%s"
+    id   = "%s"
+  }
+}
+`, subscriberType, subscriberID)
+}
+
+func testAccCheckPagerDutyResponsePlayConferenceConfig(number, url string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_response_play" "foo" {
+  name              = "foo"
+  from              = "foo@foo.test"
+  conference_number = "%s"
+  conference_url    = "%s"
+}
+`, number, url)
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -18,6 +17,9 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 		Read:   resourcePagerDutyRulesetRuleRead,
 		Update: resourcePagerDutyRulesetRuleUpdate,
 		Delete: resourcePagerDutyRulesetRuleDelete,
+		CustomizeDiff: validateReferences(
+			referenceAttribute{key: "actions.*.priority.*.value", kind: "priority"},
+		),
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyRulesetRuleImport,
 		},
@@ -91,15 +93,10 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"timezone": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-											_, err := time.LoadLocation(val.(string))
-											if err != nil {
-												errs = append(errs, err)
-											}
-											return
-										},
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     validateTimeZone,
+										DiffSuppressFunc: suppressTimeZoneDiff,
 									},
 									"start_time": {
 										Type:     schema.TypeInt,
@@ -179,14 +176,9 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"value": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ValidateFunc: validateValueFunc([]string{
-											"info",
-											"error",
-											"warning",
-											"critical",
-										}),
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateSeverity(),
 									},
 								},
 							},
@@ -791,7 +783,7 @@ func resourcePagerDutyRulesetRuleCreate(d *schema.ResourceData, meta interface{}
 
 		d.SetId(catchallrule.ID)
 
-		return resourcePagerDutyRulesetRuleRead(d, meta)
+		return fetchPagerDutyRulesetRule(d, meta, genError)
 	}
 
 	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
@@ -813,22 +805,26 @@ func resourcePagerDutyRulesetRuleCreate(d *schema.ResourceData, meta interface{}
 		time.Sleep(2 * time.Second)
 		return retryErr
 	}
-	return resourcePagerDutyRulesetRuleRead(d, meta)
+	return fetchPagerDutyRulesetRule(d, meta, genError)
 }
 
-func resourcePagerDutyRulesetRuleRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutyRulesetRule(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Reading PagerDuty ruleset rule: %s", d.Id())
 	rulesetID := d.Get("ruleset").(string)
 
 	return resource.Retry(2*time.Minute, func() *resource.RetryError {
 		if rule, _, err := client.Rulesets.GetRule(rulesetID, d.Id()); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if rule != nil {
 			if rule.Conditions != nil {
 				d.Set("conditions", flattenConditions(rule.Conditions))
@@ -844,12 +840,18 @@ func resourcePagerDutyRulesetRuleRead(d *schema.ResourceData, meta interface{})
 			}
 			d.Set("position", rule.Position)
 			d.Set("disabled", rule.Disabled)
+			d.Set("catch_all", rule.CatchAll)
 			d.Set("ruleset", rulesetID)
 		}
 		return nil
 	})
 }
 
+func resourcePagerDutyRulesetRuleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty ruleset rule: %s", d.Id())
+	return fetchPagerDutyRulesetRule(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutyRulesetRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -943,10 +945,9 @@ func resourcePagerDutyRulesetRuleImport(d *schema.ResourceData, meta interface{}
 		return []*schema.ResourceData{}, err
 	}
 
-	ids := strings.Split(d.Id(), ".")
-
-	if len(ids) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_ruleset_rule. Expecting an importation ID formed as '<ruleset_id>.<ruleset_rule_id>'")
+	ids, err := parseCompositeImportID("pagerduty_ruleset_rule", d.Id(), "ruleset_id", "ruleset_rule_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 	rulesetID, ruleID := ids[0], ids[1]
 
@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"regexp"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,25 +19,36 @@ func resourcePagerDutySchedule() *schema.Resource {
 		Read:   resourcePagerDutyScheduleRead,
 		Update: resourcePagerDutyScheduleUpdate,
 		Delete: resourcePagerDutyScheduleDelete,
-		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
-			ln := diff.Get("layer.#").(int)
-			for li := 0; li <= ln; li++ {
-				rn := diff.Get(fmt.Sprintf("layer.%d.restriction.#", li)).(int)
-				for ri := 0; ri <= rn; ri++ {
-					t := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.type", li, ri)).(string)
-					if t == "daily_restriction" && diff.Get(fmt.Sprintf("layer.%d.restriction.%d.start_day_of_week", li, ri)).(int) != 0 {
-						return fmt.Errorf("start_day_of_week must only be set for a weekly_restriction schedule restriction type")
-					}
-					ds := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.duration_seconds", li, ri)).(int)
-					if t == "daily_restriction" && ds >= 3600*24 {
-						return fmt.Errorf("duration_seconds for a daily_restriction schedule restriction type must be shorter than a day")
+		CustomizeDiff: customdiff.All(
+			func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+				ln := diff.Get("layer.#").(int)
+				for li := 0; li <= ln; li++ {
+					rn := diff.Get(fmt.Sprintf("layer.%d.restriction.#", li)).(int)
+					for ri := 0; ri <= rn; ri++ {
+						t := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.type", li, ri)).(string)
+						if t == "daily_restriction" && diff.Get(fmt.Sprintf("layer.%d.restriction.%d.start_day_of_week", li, ri)).(int) != 0 {
+							return fmt.Errorf("start_day_of_week must only be set for a weekly_restriction schedule restriction type")
+						}
+						ds := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.duration_seconds", li, ri)).(int)
+						if t == "daily_restriction" && ds >= 3600*24 {
+							return fmt.Errorf("duration_seconds for a daily_restriction schedule restriction type must be shorter than a day")
+						}
 					}
 				}
-			}
-			return nil
-		},
+				return nil
+			},
+			validateReferences(
+				referenceAttribute{key: "layer.*.users", kind: "user"},
+				referenceAttribute{key: "teams", kind: "team"},
+			),
+		),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("pagerduty_schedule", "schedule", listScheduleNames),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read:   schema.DefaultTimeout(30 * time.Second),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -46,15 +57,10 @@ func resourcePagerDutySchedule() *schema.Resource {
 			},
 
 			"time_zone": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-					_, err := time.LoadLocation(val.(string))
-					if err != nil {
-						errs = append(errs, err)
-					}
-					return
-				},
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateTimeZone,
+				DiffSuppressFunc: suppressTimeZoneDiff,
 			},
 
 			"overflow": {
@@ -67,6 +73,11 @@ func resourcePagerDutySchedule() *schema.Resource {
 				Optional: true,
 				Default:  "Managed by Terraform",
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 
 			"layer": {
 				Type:     schema.TypeList,
@@ -121,8 +132,9 @@ func resourcePagerDutySchedule() *schema.Resource {
 						},
 
 						"rendered_coverage_percentage": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "The schedule is no longer rendered when it's refreshed, so this isn't kept up to date. Use the rendered_coverage_percentage attribute of the pagerduty_schedule data source instead.",
 						},
 
 						"restriction": {
@@ -140,9 +152,10 @@ func resourcePagerDutySchedule() *schema.Resource {
 									},
 
 									"start_time_of_day": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringMatch(regexp.MustCompile(`([0-1][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]`), "must be of 00:00:00 format"),
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validateTimeOfDay,
+										DiffSuppressFunc: suppressTimeOfDayDiff,
 									},
 
 									"start_day_of_week": {
@@ -180,8 +193,9 @@ func resourcePagerDutySchedule() *schema.Resource {
 							Computed: true,
 						},
 						"rendered_coverage_percentage": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "The schedule is no longer rendered when it's refreshed, so this isn't kept up to date. Use the rendered_coverage_percentage attribute of the pagerduty_schedule data source instead.",
 						},
 					},
 				},
@@ -239,21 +253,24 @@ func resourcePagerDutyScheduleCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(schedule.ID)
 
-	return resourcePagerDutyScheduleRead(d, meta)
+	return fetchPagerDutySchedule(d, meta, genError)
 }
 
-func resourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutySchedule(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Reading PagerDuty schedule: %s", d.Id())
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		if schedule, _, err := client.Schedules.Get(d.Id(), unrenderedScheduleOptions()); err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
 
-	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
-		if schedule, _, err := client.Schedules.Get(d.Id(), &pagerduty.GetScheduleOptions{}); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			return nil
 		} else if schedule != nil {
 			d.Set("name", schedule.Name)
 			d.Set("time_zone", schedule.TimeZone)
@@ -286,6 +303,11 @@ func resourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty schedule: %s", d.Id())
+	return fetchPagerDutySchedule(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutyScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -343,9 +365,13 @@ func resourcePagerDutyScheduleUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if err := clearDescription(client, d, "/schedules/"+d.Id(), "schedule"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Updating PagerDuty schedule: %s", d.Id())
 
-	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		if _, _, err := client.Schedules.Update(d.Id(), schedule, opts); err != nil {
 			return resource.RetryableError(err)
 		}
@@ -365,10 +391,14 @@ func resourcePagerDutyScheduleDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if err := checkDeletionProtection(d, "pagerduty_schedule"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty schedule: %s", d.Id())
 
 	// Retrying to give other resources (such as escalation policies) to delete
-	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if _, err := client.Schedules.Delete(d.Id()); err != nil {
 			if isErrCode(err, 400) {
 				return resource.RetryableError(err)
@@ -431,7 +461,7 @@ func expandScheduleLayers(v interface{}) ([]*pagerduty.ScheduleLayer, error) {
 
 			restriction := &pagerduty.Restriction{
 				Type:            rslr["type"].(string),
-				StartTimeOfDay:  rslr["start_time_of_day"].(string),
+				StartTimeOfDay:  normalizeTimeOfDay(rslr["start_time_of_day"].(string)),
 				StartDayOfWeek:  rslr["start_day_of_week"].(int),
 				DurationSeconds: rslr["duration_seconds"].(int),
 			}
@@ -537,6 +567,18 @@ func flattenShedTeams(teams []*pagerduty.TeamReference) []string {
 	return res
 }
 
+// unrenderedScheduleOptions asks for an empty range of the schedule, so that
+// the API returns its layers without rendering who is on call over the next
+// weeks, which the resource doesn't use and which makes reading large
+// schedules slow. The pagerduty_schedule data source renders it on request.
+func unrenderedScheduleOptions() *pagerduty.GetScheduleOptions {
+	now := time.Now().UTC().Format(time.RFC3339)
+	return &pagerduty.GetScheduleOptions{
+		Since: now,
+		Until: now,
+	}
+}
+
 func flattenScheFinalSchedule(finalSche *pagerduty.SubSchedule) []map[string]interface{} {
 	var res []map[string]interface{}
 	elem := make(map[string]interface{})
@@ -546,3 +588,28 @@ func flattenScheFinalSchedule(finalSche *pagerduty.SubSchedule) []map[string]int
 
 	return res
 }
+
+func listScheduleNames(client *pagerduty.Client, query string) ([]namedObject, error) {
+	o := &pagerduty.ListSchedulesOptions{
+		Query: query,
+	}
+
+	var objects []namedObject
+	for {
+		resp, _, err := client.Schedules.List(o)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, schedule := range resp.Schedules {
+			objects = append(objects, namedObject{ID: schedule.ID, Name: schedule.Name})
+		}
+
+		if !resp.More {
+			break
+		}
+		o.Offset = resp.Offset + resp.Limit
+	}
+
+	return objects, nil
+}
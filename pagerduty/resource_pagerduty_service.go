@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,33 +24,52 @@ func resourcePagerDutyService() *schema.Resource {
 		Read:   resourcePagerDutyServiceRead,
 		Update: resourcePagerDutyServiceUpdate,
 		Delete: resourcePagerDutyServiceDelete,
-		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
-			in := diff.Get("incident_urgency_rule.#").(int)
-			for i := 0; i <= in; i++ {
-				t := diff.Get(fmt.Sprintf("incident_urgency_rule.%d.type", i)).(string)
-				if t == "use_support_hours" && diff.Get(fmt.Sprintf("incident_urgency_rule.%d.urgency", i)).(string) != "" {
-					return fmt.Errorf("general urgency cannot be set for a use_support_hours incident urgency rule type")
+		CustomizeDiff: customdiff.All(
+			validateServiceIncidentUrgencyRule,
+			func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+				if diff.Get("on_destroy").(string) == "resolve" && diff.Get("on_destroy_from").(string) == "" {
+					return fmt.Errorf("on_destroy_from must be set to resolve the open incidents of the service on destroy")
 				}
-			}
-			return nil
-		},
+				return nil
+			},
+			validateServiceSupportHours,
+			validateReferences(
+				referenceAttribute{key: "escalation_policy", kind: "escalation_policy"},
+			),
+		),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("pagerduty_service", "service", listServiceNames),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringDoesNotMatch(regexp.MustCompile(`^$|^[ ]+$|[/\\<>&]`), "Service name can't be blank or contain '\\', '/', '&', '<', '>' or non-printable characters. "),
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringDoesNotMatch(regexp.MustCompile(`^$|^[ ]+$|[/\\<>&]`), "Service name can't be blank or contain '\\', '/', '&', '<', '>' or non-printable characters. "),
+				DiffSuppressFunc: suppressHTMLEntityDiff,
 			},
 			"html_url": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"description": {
-				Type:     schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "Managed by Terraform",
+				DiffSuppressFunc: suppressHTMLEntityDiff,
+			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  "Managed by Terraform",
+				Default:  false,
 			},
 			"alert_creation": {
 				Type:     schema.TypeString,
@@ -140,6 +163,11 @@ func resourcePagerDutyService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"acknowledgement_timeout": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -157,12 +185,14 @@ func resourcePagerDutyService() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIncidentUrgencyRuleType,
 						},
 						"urgency": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIncidentUrgency(),
 						},
 						"during_support_hours": {
 							Type:     schema.TypeList,
@@ -174,10 +204,14 @@ func resourcePagerDutyService() *schema.Resource {
 									"type": {
 										Type:     schema.TypeString,
 										Optional: true,
+										ValidateFunc: validateValueFunc([]string{
+											"constant",
+										}),
 									},
 									"urgency": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateIncidentUrgency(),
 									},
 								},
 							},
@@ -192,10 +226,14 @@ func resourcePagerDutyService() *schema.Resource {
 									"type": {
 										Type:     schema.TypeString,
 										Optional: true,
+										ValidateFunc: validateValueFunc([]string{
+											"constant",
+										}),
 									},
 									"urgency": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateIncidentUrgency(),
 									},
 								},
 							},
@@ -214,31 +252,36 @@ func resourcePagerDutyService() *schema.Resource {
 						"type": {
 							Type:     schema.TypeString,
 							Optional: true,
+							ValidateFunc: validateValueFunc([]string{
+								"fixed_time_per_day",
+							}),
 						},
 						"time_zone": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-								_, err := time.LoadLocation(val.(string))
-								if err != nil {
-									errs = append(errs, err)
-								}
-								return
-							},
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateTimeZone,
+							DiffSuppressFunc: suppressTimeZoneDiff,
 						},
 						"start_time": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateTimeOfDay,
+							DiffSuppressFunc: suppressTimeOfDayDiff,
 						},
 						"end_time": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateTimeOfDay,
+							DiffSuppressFunc: suppressTimeOfDayDiff,
 						},
 						"days_of_week": {
 							Type:     schema.TypeList,
 							Optional: true,
 							MaxItems: 7,
-							Elem:     &schema.Schema{Type: schema.TypeInt},
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(1, 7),
+							},
 						},
 					},
 				},
@@ -252,10 +295,14 @@ func resourcePagerDutyService() *schema.Resource {
 						"type": {
 							Type:     schema.TypeString,
 							Optional: true,
+							ValidateFunc: validateValueFunc([]string{
+								"urgency_change",
+							}),
 						},
 						"to_urgency": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateUrgency(),
 						},
 						"at": {
 							Type:     schema.TypeList,
@@ -265,10 +312,17 @@ func resourcePagerDutyService() *schema.Resource {
 									"type": {
 										Type:     schema.TypeString,
 										Optional: true,
+										ValidateFunc: validateValueFunc([]string{
+											"named_time",
+										}),
 									},
 									"name": {
 										Type:     schema.TypeString,
 										Optional: true,
+										ValidateFunc: validateValueFunc([]string{
+											"support_hours_start",
+											"support_hours_end",
+										}),
 									},
 								},
 							},
@@ -280,10 +334,128 @@ func resourcePagerDutyService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"destroy_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "delete",
+				ValidateFunc: validateValueFunc([]string{
+					"delete",
+					"disable",
+				}),
+			},
+			"on_destroy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validateValueFunc([]string{
+					"block",
+					"resolve",
+				}),
+			},
+			"on_destroy_resolution_note": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"on_destroy_from": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
 
+// validateIncidentUrgencyRuleType validates the type of an incident urgency
+// rule. severity_based is an urgency, often mistaken for a rule type, so it's
+// rejected with a message pointing to the urgency instead.
+func validateIncidentUrgencyRuleType(v interface{}, k string) (warns []string, errs []error) {
+	if v.(string) == "severity_based" {
+		errs = append(errs, fmt.Errorf("%s: severity_based is an urgency, not an incident urgency rule type, use type = \"constant\" with urgency = \"severity_based\" instead", k))
+		return warns, errs
+	}
+
+	return validateValueFunc([]string{
+		"constant",
+		"use_support_hours",
+	})(v, k)
+}
+
+// validateServiceIncidentUrgencyRule checks that the incident urgency rule has
+// the attributes its type needs and none of the others: a constant rule sets
+// its urgency, while a use_support_hours rule sets the urgency during and
+// outside of the support hours of the service instead.
+func validateServiceIncidentUrgencyRule(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("incident_urgency_rule.#").(int) == 0 || !diff.NewValueKnown("incident_urgency_rule.0.type") {
+		return nil
+	}
+
+	prefix := "incident_urgency_rule.0"
+	supportHoursRules := []string{"during_support_hours", "outside_support_hours"}
+
+	switch diff.Get(prefix + ".type").(string) {
+	case "constant":
+		if diff.NewValueKnown(prefix+".urgency") && diff.Get(prefix+".urgency").(string) == "" {
+			return fmt.Errorf("%s.urgency: is required for a constant incident urgency rule type", prefix)
+		}
+		for _, rule := range supportHoursRules {
+			if diff.Get(fmt.Sprintf("%s.%s.#", prefix, rule)).(int) > 0 {
+				return fmt.Errorf("%s.%s: can only be set for a use_support_hours incident urgency rule type", prefix, rule)
+			}
+		}
+	case "use_support_hours":
+		if diff.Get(prefix+".urgency").(string) != "" {
+			return fmt.Errorf("%s.urgency: general urgency cannot be set for a use_support_hours incident urgency rule type", prefix)
+		}
+		for _, rule := range supportHoursRules {
+			key := fmt.Sprintf("%s.%s", prefix, rule)
+			if diff.Get(key+".#").(int) == 0 {
+				return fmt.Errorf("%s: is required for a use_support_hours incident urgency rule type", key)
+			}
+			if diff.NewValueKnown(key+".0.urgency") && diff.Get(key+".0.urgency").(string) == "" {
+				return fmt.Errorf("%s.0.urgency: is required for a use_support_hours incident urgency rule type", key)
+			}
+		}
+		if diff.Get("support_hours.#").(int) == 0 {
+			return fmt.Errorf("%s.type: use_support_hours needs the support hours of the service, set in a support_hours block", prefix)
+		}
+	}
+
+	return nil
+}
+
+// validateServiceSupportHours checks that support hours start before they end,
+// and that scheduled actions happening at the start or end of support hours
+// have support hours to refer to. The API rejects both, but only on apply.
+func validateServiceSupportHours(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	hasSupportHours := diff.Get("support_hours.#").(int) > 0
+	if hasSupportHours && diff.NewValueKnown("support_hours.0.start_time") && diff.NewValueKnown("support_hours.0.end_time") {
+		start := diff.Get("support_hours.0.start_time").(string)
+		end := diff.Get("support_hours.0.end_time").(string)
+		if start != "" && end != "" && normalizeTimeOfDay(start) >= normalizeTimeOfDay(end) {
+			return fmt.Errorf("support_hours.0.start_time (%s) must be before support_hours.0.end_time (%s)", start, end)
+		}
+	}
+
+	actions := diff.Get("scheduled_actions.#").(int)
+	for i := 0; i < actions; i++ {
+		times := diff.Get(fmt.Sprintf("scheduled_actions.%d.at.#", i)).(int)
+		for j := 0; j < times; j++ {
+			key := fmt.Sprintf("scheduled_actions.%d.at.%d", i, j)
+			if diff.Get(key+".type").(string) != "named_time" {
+				continue
+			}
+
+			name := diff.Get(key + ".name").(string)
+			if !hasSupportHours {
+				return fmt.Errorf("%s.name: %s refers to the support hours of the service, which needs a support_hours block", key, name)
+			}
+			if t := diff.Get("incident_urgency_rule.0.type").(string); t != "use_support_hours" {
+				return fmt.Errorf("%s.name: %s can only be used when the incident_urgency_rule type is use_support_hours, got: %q", key, name, t)
+			}
+		}
+	}
+
+	return nil
+}
+
 func buildServiceStruct(d *schema.ResourceData) (*pagerduty.Service, error) {
 	service := pagerduty.Service{
 		Name: d.Get("name").(string),
@@ -358,6 +530,14 @@ func buildServiceStruct(d *schema.ResourceData) (*pagerduty.Service, error) {
 	if attr, ok := d.GetOk("support_hours"); ok {
 		service.SupportHours = expandSupportHours(attr)
 	}
+
+	// The status is only sent when it's changed, the API derives the other
+	// statuses of enabled services from their incidents.
+	if d.Get("disabled").(bool) {
+		service.Status = "disabled"
+	} else if d.HasChange("disabled") {
+		service.Status = "active"
+	}
 	return &service, nil
 }
 
@@ -367,7 +547,7 @@ func fetchService(d *schema.ResourceData, meta interface{}, errCallback func(err
 		return err
 	}
 
-	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		service, _, err := client.Services.Get(d.Id(), &pagerduty.GetServiceOptions{})
 		if err != nil {
 			log.Printf("[WARN] Service read error")
@@ -399,6 +579,30 @@ func resourcePagerDutyServiceCreate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	if d.Get("adopt_existing").(bool) {
+		id, err := findExistingByName(client, "service", service.Name, listServiceNames)
+		if err != nil {
+			return err
+		}
+		if id != "" {
+			existing, _, err := client.Services.Get(id, &pagerduty.GetServiceOptions{})
+			if err != nil {
+				return err
+			}
+			escalationPolicy := ""
+			if existing.EscalationPolicy != nil {
+				escalationPolicy = existing.EscalationPolicy.ID
+			}
+			if err := checkAdoptedAttribute("service", service.Name, id, "escalation_policy", escalationPolicy, d.Get("escalation_policy").(string)); err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] Adopting existing PagerDuty service %s (%s)", service.Name, id)
+			d.SetId(id)
+			return resourcePagerDutyServiceUpdate(d, meta)
+		}
+	}
+
 	log.Printf("[INFO] Creating PagerDuty service %s", service.Name)
 
 	service, _, err = client.Services.Create(service)
@@ -427,6 +631,10 @@ func resourcePagerDutyServiceUpdate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	if err := clearDescription(client, d, "/services/"+d.Id(), "service"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Updating PagerDuty service %s", d.Id())
 
 	updatedService, _, err := client.Services.Update(d.Id(), service)
@@ -443,8 +651,44 @@ func resourcePagerDutyServiceDelete(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	if err := checkDeletionProtection(d, "pagerduty_service"); err != nil {
+		return err
+	}
+
+	if d.Get("destroy_behavior").(string) == "disable" {
+		log.Printf("[INFO] Disabling PagerDuty service %s instead of deleting it", d.Id())
+
+		if err := disableService(client, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+
+		d.SetId("")
+		return nil
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty service %s", d.Id())
 
+	if onDestroy := d.Get("on_destroy").(string); onDestroy != "" {
+		incidents, err := listOpenServiceIncidents(client, d.Id(), d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+
+		if len(incidents) > 0 {
+			if onDestroy == "block" {
+				ids := make([]string, 0, len(incidents))
+				for _, i := range incidents {
+					ids = append(ids, i.ID)
+				}
+				return fmt.Errorf("Service %s can't be deleted while it has %d open incident(s): %s", d.Id(), len(ids), strings.Join(ids, ", "))
+			}
+
+			if err := resolveServiceIncidents(client, incidents, d.Get("on_destroy_from").(string), d.Get("on_destroy_resolution_note").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+				return err
+			}
+		}
+	}
+
 	if _, err := client.Services.Delete(d.Id()); err != nil {
 		return err
 	}
@@ -456,11 +700,106 @@ func resourcePagerDutyServiceDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+// disableService only sends the status of the service, as the client would
+// reset the timeouts of the service left unset.
+func disableService(client *pagerduty.Client, id string, timeout time.Duration) error {
+	body := map[string]interface{}{
+		"service": map[string]interface{}{
+			"status": "disabled",
+		},
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		if _, err := apiRequest(client, "PUT", "/services/"+id, nil, body, nil); err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func listOpenServiceIncidents(client *pagerduty.Client, serviceID string, timeout time.Duration) ([]*incidentSummary, error) {
+	query := url.Values{}
+	query.Add("service_ids[]", serviceID)
+	query.Add("statuses[]", "triggered")
+	query.Add("statuses[]", "acknowledged")
+	query.Set("date_range", "all")
+
+	var incidents []*incidentSummary
+	retryErr := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		if incidents, err = listIncidents(client, query); err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	return incidents, retryErr
+}
+
+// resolveServiceIncidents resolves the incidents on behalf of the user with
+// the given email, as required by the incidents API.
+func resolveServiceIncidents(client *pagerduty.Client, incidents []*incidentSummary, from, note string, timeout time.Duration) error {
+	headers := http.Header{}
+	headers.Set("From", from)
+
+	// The API resolves at most 250 incidents per request.
+	for start := 0; start < len(incidents); start += 250 {
+		end := start + 250
+		if end > len(incidents) {
+			end = len(incidents)
+		}
+
+		var refs []map[string]interface{}
+		for _, i := range incidents[start:end] {
+			ref := map[string]interface{}{
+				"id":     i.ID,
+				"type":   "incident_reference",
+				"status": "resolved",
+			}
+			if note != "" {
+				ref["resolution"] = note
+			}
+			refs = append(refs, ref)
+		}
+
+		log.Printf("[INFO] Resolving %d open PagerDuty incident(s)", len(refs))
+
+		retryErr := resource.Retry(timeout, func() *resource.RetryError {
+			body := map[string]interface{}{"incidents": refs}
+			if _, err := apiRequestWithHeaders(client, "PUT", "/incidents", nil, headers, body, nil); err != nil {
+				if isErrCode(err, 429) {
+					time.Sleep(2 * time.Second)
+					return resource.RetryableError(err)
+				}
+
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	return nil
+}
+
 func flattenService(d *schema.ResourceData, service *pagerduty.Service) error {
 	d.Set("name", service.Name)
 	d.Set("type", service.Type)
 	d.Set("html_url", service.HTMLURL)
 	d.Set("status", service.Status)
+	d.Set("disabled", service.Status == "disabled")
 	d.Set("created_at", service.CreatedAt)
 	d.Set("escalation_policy", service.EscalationPolicy.ID)
 	d.Set("description", service.Description)
@@ -655,11 +994,11 @@ func expandSupportHours(v interface{}) *pagerduty.SupportHours {
 	}
 
 	if v, ok := rsh["start_time"]; ok {
-		supportHours.StartTime = v.(string)
+		supportHours.StartTime = normalizeTimeOfDay(v.(string))
 	}
 
 	if v, ok := rsh["end_time"]; ok {
-		supportHours.EndTime = v.(string)
+		supportHours.EndTime = normalizeTimeOfDay(v.(string))
 	}
 
 	if v, ok := rsh["days_of_week"]; ok {
@@ -746,3 +1085,28 @@ func flattenScheduledActionAt(v *pagerduty.At) []interface{} {
 	at := map[string]interface{}{"type": v.Type, "name": v.Name}
 	return []interface{}{at}
 }
+
+func listServiceNames(client *pagerduty.Client, query string) ([]namedObject, error) {
+	o := &pagerduty.ListServicesOptions{
+		Query: query,
+	}
+
+	var objects []namedObject
+	for {
+		resp, _, err := client.Services.List(o)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, service := range resp.Services {
+			objects = append(objects, namedObject{ID: service.ID, Name: service.Name})
+		}
+
+		if !resp.More {
+			break
+		}
+		o.Offset = resp.Offset + resp.Limit
+	}
+
+	return objects, nil
+}
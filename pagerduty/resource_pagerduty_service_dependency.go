@@ -3,7 +3,6 @@ package pagerduty
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,9 +12,10 @@ import (
 
 func resourcePagerDutyServiceDependency() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyServiceDependencyAssociate,
-		Read:   resourcePagerDutyServiceDependencyRead,
-		Delete: resourcePagerDutyServiceDependencyDisassociate,
+		Create:        resourcePagerDutyServiceDependencyAssociate,
+		Read:          resourcePagerDutyServiceDependencyRead,
+		Delete:        resourcePagerDutyServiceDependencyDisassociate,
+		CustomizeDiff: checkServiceDependencyCycles,
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyServiceDependencyImport,
 		},
@@ -306,9 +306,11 @@ func findDependencySetState(depID, serviceID, serviceType string, d *schema.Reso
 					if err := d.Set("dependency", flattenRelationship(rel)); err != nil {
 						return resource.NonRetryableError(err)
 					}
-					break
+					return nil
 				}
 			}
+
+			d.SetId("")
 		}
 		return nil
 	})
@@ -321,10 +323,9 @@ func findDependencySetState(depID, serviceID, serviceType string, d *schema.Reso
 }
 
 func resourcePagerDutyServiceDependencyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	ids := strings.Split(d.Id(), ".")
-
-	if len(ids) != 3 {
-		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_service_dependency. Expecting an importation ID formed as '<supporting_service_id>.<supporting_service_type>.<service_dependency_id>'")
+	ids, err := parseCompositeImportID("pagerduty_service_dependency", d.Id(), "supporting_service_id", "supporting_service_type", "service_dependency_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 	sid, st, id := ids[0], ids[1], ids[2]
 
@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -175,6 +176,34 @@ func TestAccPagerDutyTechnicalServiceDependency_Basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyTechnicalServiceDependency_Cycle(t *testing.T) {
+	dependentService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	supportingService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTechnicalServiceDependencyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTechnicalServiceDependencyConfig(dependentService, supportingService, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTechnicalServiceDependencyExists("pagerduty_service_dependency.bar"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyTechnicalServiceDependencyCycleConfig(dependentService, supportingService, username, email, escalationPolicy),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`creates a cycle: service P\w+ -> service P\w+ -> service P\w+`),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyTechnicalServiceDependencyExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -289,3 +318,22 @@ resource "pagerduty_service_dependency" "bar" {
 }
 `, username, email, escalationPolicy, supportingService, dependentService)
 }
+
+func testAccCheckPagerDutyTechnicalServiceDependencyCycleConfig(dependentService, supportingService, username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "pagerduty_service_dependency" "baz" {
+	dependency {
+		dependent_service {
+			id = pagerduty_service.supportBar.id
+			type = "service"
+		}
+		supporting_service {
+			id = pagerduty_service.dependBar.id
+			type = "service"
+		}
+	}
+}
+`, testAccCheckPagerDutyTechnicalServiceDependencyConfig(dependentService, supportingService, username, email, escalationPolicy))
+}
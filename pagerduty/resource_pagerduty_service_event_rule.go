@@ -3,7 +3,6 @@ package pagerduty
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -17,6 +16,9 @@ func resourcePagerDutyServiceEventRule() *schema.Resource {
 		Read:   resourcePagerDutyServiceEventRuleRead,
 		Update: resourcePagerDutyServiceEventRuleUpdate,
 		Delete: resourcePagerDutyServiceEventRuleDelete,
+		CustomizeDiff: validateReferences(
+			referenceAttribute{key: "actions.*.priority.*.value", kind: "priority"},
+		),
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyServiceEventRuleImport,
 		},
@@ -86,15 +88,10 @@ func resourcePagerDutyServiceEventRule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"timezone": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-											_, err := time.LoadLocation(val.(string))
-											if err != nil {
-												errs = append(errs, err)
-											}
-											return
-										},
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     validateTimeZone,
+										DiffSuppressFunc: suppressTimeZoneDiff,
 									},
 									"start_time": {
 										Type:     schema.TypeInt,
@@ -174,14 +171,9 @@ func resourcePagerDutyServiceEventRule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"value": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ValidateFunc: validateValueFunc([]string{
-											"info",
-											"error",
-											"warning",
-											"critical",
-										}),
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateSeverity(),
 									},
 								},
 							},
@@ -358,22 +350,26 @@ func resourcePagerDutyServiceEventRuleCreate(d *schema.ResourceData, meta interf
 		time.Sleep(2 * time.Second)
 		return retryErr
 	}
-	return resourcePagerDutyServiceEventRuleRead(d, meta)
+	return fetchPagerDutyServiceEventRule(d, meta, genError)
 }
 
-func resourcePagerDutyServiceEventRuleRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutyServiceEventRule(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Reading PagerDuty service event rule: %s", d.Id())
 	serviceID := d.Get("service").(string)
 
 	return resource.Retry(2*time.Minute, func() *resource.RetryError {
 		if rule, _, err := client.Services.GetEventRule(serviceID, d.Id()); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if rule != nil {
 			if rule.Conditions != nil {
 				d.Set("conditions", flattenConditions(rule.Conditions))
@@ -395,6 +391,11 @@ func resourcePagerDutyServiceEventRuleRead(d *schema.ResourceData, meta interfac
 	})
 }
 
+func resourcePagerDutyServiceEventRuleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty service event rule: %s", d.Id())
+	return fetchPagerDutyServiceEventRule(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutyServiceEventRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -453,10 +454,9 @@ func resourcePagerDutyServiceEventRuleImport(d *schema.ResourceData, meta interf
 		return []*schema.ResourceData{}, err
 	}
 
-	ids := strings.Split(d.Id(), ".")
-
-	if len(ids) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_service_event_rule. Expecting an importation ID formed as '<service_id>.<service_event_rule_id>'")
+	ids, err := parseCompositeImportID("pagerduty_service_event_rule", d.Id(), "service_id", "service_event_rule_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 	serviceID, ruleID := ids[0], ids[1]
 
@@ -3,11 +3,10 @@ package pagerduty
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -23,13 +22,16 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 		Read:   resourcePagerDutyServiceIntegrationRead,
 		Update: resourcePagerDutyServiceIntegrationUpdate,
 		Delete: resourcePagerDutyServiceIntegrationDelete,
-		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
-			t := diff.Get("type").(string)
-			if t == "generic_email_inbound_integration" && diff.Get("integration_email").(string) == "" && diff.NewValueKnown("integration_email") {
-				return errors.New(errEmailIntegrationMustHaveEmail)
-			}
-			return nil
-		},
+		CustomizeDiff: customdiff.All(
+			func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+				t := diff.Get("type").(string)
+				if t == "generic_email_inbound_integration" && diff.Get("integration_email").(string) == "" && diff.NewValueKnown("integration_email") {
+					return errors.New(errEmailIntegrationMustHaveEmail)
+				}
+				return nil
+			},
+			logServiceIntegrationReplacement,
+		),
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyServiceIntegrationImport,
 		},
@@ -75,9 +77,11 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				Computed: true,
 			},
 			"integration_email": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateEmail,
+				DiffSuppressFunc: suppressCaseDiff,
 			},
 			"html_url": {
 				Type:     schema.TypeString,
@@ -299,6 +303,28 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 	}
 }
 
+// serviceIntegrationReplacementReasons explains what replacing a service
+// integration means for the senders of events, as the plan only shows that
+// the attribute forces replacement.
+var serviceIntegrationReplacementReasons = map[string]string{
+	"service": "the integration moves to another service, and gets a new integration key and email",
+	"type":    "the integration key or email will change, and senders must be updated with the new one",
+	"vendor":  "the integration key or email will change, and senders must be updated with the new one",
+}
+
+func logServiceIntegrationReplacement(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, attr := range []string{"service", "type", "vendor"} {
+		if diff.HasChange(attr) {
+			log.Printf("[WARN] PagerDuty service integration %s will be replaced because %s changed: %s", diff.Id(), attr, serviceIntegrationReplacementReasons[attr])
+		}
+	}
+	return nil
+}
+
 func buildServiceIntegrationStruct(d *schema.ResourceData) (*pagerduty.Integration, error) {
 	serviceIntegration := &pagerduty.Integration{
 		Name: d.Get("name").(string),
@@ -726,10 +752,9 @@ func resourcePagerDutyServiceIntegrationImport(d *schema.ResourceData, meta inte
 		return []*schema.ResourceData{}, err
 	}
 
-	ids := strings.Split(d.Id(), ".")
-
-	if len(ids) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_service_integration. Expecting an importation ID formed as '<service_id>.<integration_id>'")
+	ids, err := parseCompositeImportID("pagerduty_service_integration", d.Id(), "service_id", "integration_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 	sid, id := ids[0], ids[1]
 
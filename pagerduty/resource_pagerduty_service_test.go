@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -130,6 +131,134 @@ func TestAccPagerDutyService_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyService_SupportHoursValidation(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceWithScheduledActionsConfig(username, email, escalationPolicy, service, `
+	support_hours {
+		type         = "fixed_time_per_day"
+		time_zone    = "America/Lima"
+		start_time   = "17:00"
+		end_time     = "09:00:00"
+		days_of_week = [ 1, 2, 3, 4, 5 ]
+	}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`support_hours.0.start_time \(17:00\) must be before support_hours.0.end_time \(09:00:00\)`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceWithScheduledActionsConfig(username, email, escalationPolicy, service, `
+	support_hours {
+		type         = "fixed_time_per_day"
+		time_zone    = "America/Lima"
+		start_time   = "09:00:00"
+		end_time     = "17:00:00"
+		days_of_week = [ 0, 1, 2 ]
+	}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected support_hours.0.days_of_week.0 to be in the range \(1 - 7\), got 0`),
+			},
+			{
+				Config:      testAccCheckPagerDutyServiceWithScheduledActionsConfig(username, email, escalationPolicy, service, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("scheduled_actions.0.at.0.name: support_hours_start refers to the support hours of the service, which needs a support_hours block"),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyService_IncidentUrgencyRuleValidation(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	supportHours := `
+	support_hours {
+		type         = "fixed_time_per_day"
+		time_zone    = "America/Lima"
+		start_time   = "09:00:00"
+		end_time     = "17:00:00"
+		days_of_week = [ 1, 2, 3, 4, 5 ]
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceWithIncidentUrgencyRuleConfig(username, email, escalationPolicy, service, `
+		type = "constant"`, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("incident_urgency_rule.0.urgency: is required for a constant incident urgency rule type"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceWithIncidentUrgencyRuleConfig(username, email, escalationPolicy, service, `
+		type    = "constant"
+		urgency = "severity_based"
+		during_support_hours {
+			type    = "constant"
+			urgency = "high"
+		}`, supportHours),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("incident_urgency_rule.0.during_support_hours: can only be set for a use_support_hours incident urgency rule type"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceWithIncidentUrgencyRuleConfig(username, email, escalationPolicy, service, `
+		type = "use_support_hours"
+		during_support_hours {
+			type    = "constant"
+			urgency = "high"
+		}`, supportHours),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("incident_urgency_rule.0.outside_support_hours: is required for a use_support_hours incident urgency rule type"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceWithIncidentUrgencyRuleConfig(username, email, escalationPolicy, service, `
+		type = "use_support_hours"
+		during_support_hours {
+			type    = "constant"
+			urgency = "high"
+		}
+		outside_support_hours {
+			type = "constant"
+		}`, supportHours),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("incident_urgency_rule.0.outside_support_hours.0.urgency: is required"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceWithIncidentUrgencyRuleConfig(username, email, escalationPolicy, service, `
+		type = "use_support_hours"
+		during_support_hours {
+			type    = "constant"
+			urgency = "severity_based"
+		}
+		outside_support_hours {
+			type    = "constant"
+			urgency = "low"
+		}`, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("incident_urgency_rule.0.type: use_support_hours needs the support hours of the service"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceWithIncidentUrgencyRuleConfig(username, email, escalationPolicy, service, `
+		type = "severity_based"`, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`incident_urgency_rule.0.type: severity_based is an urgency, not an incident urgency rule type, use type = "constant" with urgency = "severity_based" instead`),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyService_AlertGrouping(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -685,6 +814,200 @@ func testAccCheckPagerDutyServiceExists(n string) resource.TestCheckFunc {
 	}
 }
 
+func TestAccPagerDutyService_OnDestroy(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyServiceExtraConfig(username, email, escalationPolicy, service, `on_destroy = "resolve"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("on_destroy_from must be set"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceExtraConfig(username, email, escalationPolicy, service, `
+  on_destroy                 = "resolve"
+  on_destroy_from            = pagerduty_user.foo.email
+  on_destroy_resolution_note = "Service decommissioned"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "on_destroy", "resolve"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "on_destroy_from", email),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "on_destroy_resolution_note", "Service decommissioned"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceExtraConfig(username, email, escalationPolicy, service, `on_destroy = "block"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "on_destroy", "block"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyService_Disabled(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceExtraConfig(username, email, escalationPolicy, service, `disabled = true`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "disabled", "true"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "status", "disabled"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceExtraConfig(username, email, escalationPolicy, service, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "disabled", "false"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "status", "active"),
+					testAccCheckPagerDutyServiceDisableOutOfBand("pagerduty_service.foo"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckPagerDutyServiceDisableOutOfBand disables the service as a user
+// would in the web app, for the next plan to show the drift.
+func TestAccPagerDutyService_DestroyBehaviorDisable(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDisabledOnDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceExtraConfig(username, email, escalationPolicy, service, `destroy_behavior = "disable"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "destroy_behavior", "disable"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckPagerDutyServiceDisabledOnDestroy checks the service was left
+// disabled and cleans it up, as the provider didn't delete it.
+func testAccCheckPagerDutyServiceDisabledOnDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_service" {
+			continue
+		}
+
+		service, _, err := client.Services.Get(r.Primary.ID, &pagerduty.GetServiceOptions{})
+		if err != nil {
+			return err
+		}
+
+		if service.Status != "disabled" {
+			return fmt.Errorf("Expected service %s to be disabled, got %s", r.Primary.ID, service.Status)
+		}
+
+		if _, err := client.Services.Delete(r.Primary.ID); err != nil {
+			return err
+		}
+	}
+
+	return testAccCheckPagerDutyServiceDestroy(s)
+}
+
+func TestAccPagerDutyService_CheckReferences(t *testing.T) {
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyServiceCheckReferencesConfig(service),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("escalation_policy: escalation policy PNOTREAL does not exist"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyServiceDisableOutOfBand(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		return disableService(client, rs.Primary.ID, 2*time.Minute)
+	}
+}
+
+func testAccCheckPagerDutyServiceExtraConfig(username, email, escalationPolicy, service, extra string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+
+  %s
+}
+`, username, email, escalationPolicy, service, extra)
+}
+
 func testAccCheckPagerDutyServiceConfig(username, email, escalationPolicy, service string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1018,6 +1341,87 @@ resource "pagerduty_service" "foo" {
 `, username, email, escalationPolicy, service)
 }
 
+func testAccCheckPagerDutyServiceWithIncidentUrgencyRuleConfig(username, email, escalationPolicy, service, rule, supportHours string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	num_loops   = 2
+
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%s"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+
+	incident_urgency_rule {%s
+	}
+%s
+}
+`, username, email, escalationPolicy, service, rule, supportHours)
+}
+
+func testAccCheckPagerDutyServiceWithScheduledActionsConfig(username, email, escalationPolicy, service, supportHours string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	num_loops   = 2
+
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%s"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+
+	incident_urgency_rule {
+		type = "use_support_hours"
+
+		during_support_hours {
+			type    = "constant"
+			urgency = "high"
+		}
+		outside_support_hours {
+			type    = "constant"
+			urgency = "low"
+		}
+	}
+%s
+
+	scheduled_actions {
+		type = "urgency_change"
+		to_urgency = "high"
+		at {
+			type = "named_time"
+			name = "support_hours_start"
+		}
+	}
+}
+`, username, email, escalationPolicy, service, supportHours)
+}
+
 func testAccCheckPagerDutyServiceWithIncidentUrgencyRulesConfigError(username, email, escalationPolicy, service string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1243,3 +1647,16 @@ resource "pagerduty_service" "foo" {
 }
 `, username, email, escalationPolicy, service)
 }
+
+func testAccCheckPagerDutyServiceCheckReferencesConfig(service string) string {
+	return fmt.Sprintf(`
+provider "pagerduty" {
+  check_references = true
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = "PNOTREAL"
+}
+`, service)
+}
@@ -3,7 +3,6 @@ package pagerduty
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -14,6 +13,30 @@ import (
 const AppBaseUrl = "https://app.pagerduty.com"
 const StarWildcardConfig = "*"
 
+// slackConnection extends the Slack connection of the go-pagerduty client
+// with the incident channel options of the Slack integration API, which the
+// client doesn't cover yet.
+type slackConnection struct {
+	pagerduty.SlackConnection
+	DedicatedChannel *slackDedicatedChannel `json:"dedicated_channel,omitempty"`
+	ThreadUpdates    *bool                  `json:"thread_updates,omitempty"`
+}
+
+// slackDedicatedChannel represents the configuration of the Slack channels
+// created for each incident of a connection.
+type slackDedicatedChannel struct {
+	Enabled      bool   `json:"enabled"`
+	NameTemplate string `json:"name_template,omitempty"`
+}
+
+type slackConnectionPayload struct {
+	SlackConnection *slackConnection `json:"slack_connection"`
+}
+
+func slackConnectionsPath(workspaceID string) string {
+	return fmt.Sprintf("/integration-slack/workspaces/%s/connections", workspaceID)
+}
+
 func resourcePagerDutySlackConnection() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePagerDutySlackConnectionCreate,
@@ -91,24 +114,78 @@ func resourcePagerDutySlackConnection() *schema.Resource {
 					},
 				},
 			},
+			"dedicated_channel": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"name_template": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"thread_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
-func buildSlackConnectionStruct(d *schema.ResourceData) (*pagerduty.SlackConnection, error) {
-	slackConn := pagerduty.SlackConnection{
-		SourceID:         d.Get("source_id").(string),
-		SourceName:       d.Get("source_name").(string),
-		SourceType:       d.Get("source_type").(string),
-		ChannelID:        d.Get("channel_id").(string),
-		ChannelName:      d.Get("channel_name").(string),
-		WorkspaceID:      d.Get("workspace_id").(string),
-		NotificationType: d.Get("notification_type").(string),
-		Config:           expandConnectionConfig(d.Get("config").(interface{})),
+func buildSlackConnectionStruct(d *schema.ResourceData) (*slackConnection, error) {
+	threadUpdates := d.Get("thread_updates").(bool)
+	slackConn := slackConnection{
+		SlackConnection: pagerduty.SlackConnection{
+			SourceID:         d.Get("source_id").(string),
+			SourceName:       d.Get("source_name").(string),
+			SourceType:       d.Get("source_type").(string),
+			ChannelID:        d.Get("channel_id").(string),
+			ChannelName:      d.Get("channel_name").(string),
+			WorkspaceID:      d.Get("workspace_id").(string),
+			NotificationType: d.Get("notification_type").(string),
+			Config:           expandConnectionConfig(d.Get("config").(interface{})),
+		},
+		DedicatedChannel: expandSlackDedicatedChannel(d.Get("dedicated_channel").([]interface{})),
+		ThreadUpdates:    &threadUpdates,
 	}
 	return &slackConn, nil
 }
 
+func expandSlackDedicatedChannel(v []interface{}) *slackDedicatedChannel {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	c := v[0].(map[string]interface{})
+
+	return &slackDedicatedChannel{
+		Enabled:      c["enabled"].(bool),
+		NameTemplate: c["name_template"].(string),
+	}
+}
+
+func flattenSlackDedicatedChannel(c *slackDedicatedChannel) []map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"enabled":       c.Enabled,
+			"name_template": c.NameTemplate,
+		},
+	}
+}
+
 func resourcePagerDutySlackConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).SlackClient()
 	if err != nil {
@@ -123,11 +200,12 @@ func resourcePagerDutySlackConnectionCreate(d *schema.ResourceData, meta interfa
 		}
 		log.Printf("[INFO] Creating PagerDuty slack connection for source %s and slack channel %s", slackConn.SourceID, slackConn.ChannelID)
 
-		if slackConn, _, err = client.SlackConnections.Create(slackConn.WorkspaceID, slackConn); err != nil {
+		v := new(slackConnectionPayload)
+		if _, err = apiRequest(client, "POST", slackConnectionsPath(slackConn.WorkspaceID), nil, &slackConnectionPayload{SlackConnection: slackConn}, v); err != nil {
 			return resource.RetryableError(err)
-		} else if slackConn != nil {
-			d.SetId(slackConn.ID)
-			d.Set("workspace_id", slackConn.WorkspaceID)
+		} else if v.SlackConnection != nil {
+			d.SetId(v.SlackConnection.ID)
+			d.Set("workspace_id", v.SlackConnection.WorkspaceID)
 		}
 		return nil
 	})
@@ -135,24 +213,29 @@ func resourcePagerDutySlackConnectionCreate(d *schema.ResourceData, meta interfa
 		time.Sleep(2 * time.Second)
 		return retryErr
 	}
-	return resourcePagerDutySlackConnectionRead(d, meta)
+	return fetchPagerDutySlackConnection(d, meta, genError)
 }
 
-func resourcePagerDutySlackConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutySlackConnection(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).SlackClient()
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Reading PagerDuty slack connection %s", d.Id())
-
 	workspaceID := d.Get("workspace_id").(string)
 	log.Printf("[DEBUG] Read Slack Connection: workspace_id %s", workspaceID)
 
 	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		if slackConn, _, err := client.SlackConnections.Get(workspaceID, d.Id()); err != nil {
-			return resource.RetryableError(err)
-		} else if slackConn != nil {
+		v := new(slackConnectionPayload)
+		if _, err := apiRequest(client, "GET", fmt.Sprintf("%s/%s", slackConnectionsPath(workspaceID), d.Id()), nil, nil, v); err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		} else if slackConn := v.SlackConnection; slackConn != nil {
 			d.Set("source_id", slackConn.SourceID)
 			d.Set("source_name", slackConn.SourceName)
 			d.Set("source_type", slackConn.SourceType)
@@ -160,6 +243,10 @@ func resourcePagerDutySlackConnectionRead(d *schema.ResourceData, meta interface
 			d.Set("channel_name", slackConn.ChannelName)
 			d.Set("notification_type", slackConn.NotificationType)
 			d.Set("config", flattenConnectionConfig(slackConn.Config))
+			d.Set("dedicated_channel", flattenSlackDedicatedChannel(slackConn.DedicatedChannel))
+			if slackConn.ThreadUpdates != nil {
+				d.Set("thread_updates", *slackConn.ThreadUpdates)
+			}
 		}
 		return nil
 	})
@@ -172,6 +259,11 @@ func resourcePagerDutySlackConnectionRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourcePagerDutySlackConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty slack connection %s", d.Id())
+	return fetchPagerDutySlackConnection(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutySlackConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).SlackClient()
 	if err != nil {
@@ -184,7 +276,7 @@ func resourcePagerDutySlackConnectionUpdate(d *schema.ResourceData, meta interfa
 	}
 	log.Printf("[INFO] Updating PagerDuty slack connection %s", d.Id())
 
-	if _, _, err := client.SlackConnections.Update(slackConn.WorkspaceID, d.Id(), slackConn); err != nil {
+	if _, err := apiRequest(client, "PUT", fmt.Sprintf("%s/%s", slackConnectionsPath(slackConn.WorkspaceID), d.Id()), nil, &slackConnectionPayload{SlackConnection: slackConn}, nil); err != nil {
 		return err
 	}
 
@@ -288,10 +380,9 @@ func resourcePagerDutySlackConnectionImport(d *schema.ResourceData, meta interfa
 		return nil, err
 	}
 
-	ids := strings.Split(d.Id(), ".")
-
-	if len(ids) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_slack_connection. Expecting an importation ID formed as '<workspace_id>.<slack_connection_id>'")
+	ids, err := parseCompositeImportID("pagerduty_slack_connection", d.Id(), "workspace_id", "slack_connection_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 	workspaceID, connectionID := ids[0], ids[1]
 
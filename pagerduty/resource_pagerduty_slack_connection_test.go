@@ -83,6 +83,40 @@ func TestAccPagerDutySlackConnection_Team(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutySlackConnection_DedicatedChannel(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutySlackConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutySlackConnectionConfigTeam(team, workspaceID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutySlackConnectionExists("pagerduty_slack_connection.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_slack_connection.foo", "dedicated_channel.#", "0"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_slack_connection.foo", "thread_updates", "false"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutySlackConnectionConfigDedicatedChannel(team, workspaceID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutySlackConnectionExists("pagerduty_slack_connection.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_slack_connection.foo", "dedicated_channel.0.enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_slack_connection.foo", "dedicated_channel.0.name_template", "inc-{{incident.number}}"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_slack_connection.foo", "thread_updates", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutySlackConnection_Envar(t *testing.T) {
 	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
@@ -392,6 +426,32 @@ func testAccCheckPagerDutySlackConnectionConfigTeamUpdated(team, workspaceID, ch
 		`, team, workspaceID, channelID)
 }
 
+func testAccCheckPagerDutySlackConnectionConfigDedicatedChannel(team, workspaceID, channelID string) string {
+	return fmt.Sprintf(`
+		resource "pagerduty_team" "foo" {
+			name = "%s"
+		}
+		resource "pagerduty_slack_connection" "foo" {
+			source_id = pagerduty_team.foo.id
+			source_type = "team_reference"
+			workspace_id = "%s"
+			channel_id = "%s"
+			notification_type = "responder"
+			config {
+				events = [
+					"incident.triggered",
+					"incident.acknowledged",
+					"incident.resolved"
+				]
+			}
+			dedicated_channel {
+				name_template = "inc-{{incident.number}}"
+			}
+			thread_updates = true
+		}
+		`, team, workspaceID, channelID)
+}
+
 func testAccCheckPagerDutySlackConnectionConfigEnvar(team, channelID string) string {
 	return fmt.Sprintf(`
 		resource "pagerduty_team" "foo" {
@@ -0,0 +1,212 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// Standards are built into every account: they can't be created or deleted,
+// so pagerduty_standard manages the settings of an existing one.
+func resourcePagerDutyStandard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyStandardCreate,
+		Read:   resourcePagerDutyStandardRead,
+		Update: resourcePagerDutyStandardUpdate,
+		Delete: resourcePagerDutyStandardDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyStandardImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"standard_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"exclusion": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "technical_service_reference",
+							ValidateFunc: validateValueFunc([]string{
+								"technical_service_reference",
+							}),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildStandardStruct(d *schema.ResourceData) *standard {
+	s := &standard{
+		Active:      d.Get("active").(bool),
+		Description: d.Get("description").(string),
+		Exclusions:  []*standardInclusion{},
+	}
+
+	for _, v := range d.Get("exclusion").(*schema.Set).List() {
+		e := v.(map[string]interface{})
+		s.Exclusions = append(s.Exclusions, &standardInclusion{
+			ID:   e["id"].(string),
+			Type: e["type"].(string),
+		})
+	}
+
+	return s
+}
+
+// getStandard looks the standard up in the list of standards, as the API has
+// no endpoint to get a single standard. It returns nil when there's no such
+// standard.
+func getStandard(client *pagerduty.Client, id string) (*standard, error) {
+	resp := new(listStandardsResponse)
+	if _, err := apiRequest(client, "GET", "/standards", nil, nil, resp); err != nil {
+		return nil, err
+	}
+
+	for _, s := range resp.Standards {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourcePagerDutyStandardCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	id := d.Get("standard_id").(string)
+
+	log.Printf("[INFO] Adopting PagerDuty standard %s", id)
+
+	s, err := getStandard(client, id)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return fmt.Errorf("Unable to locate any standard with the id: %s", id)
+	}
+
+	d.SetId(id)
+
+	return resourcePagerDutyStandardUpdate(d, meta)
+}
+
+func resourcePagerDutyStandardRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty standard %s", d.Id())
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		s, err := getStandard(client, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+		if s == nil {
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("standard_id", s.ID)
+		d.Set("active", s.Active)
+		d.Set("description", s.Description)
+		d.Set("name", s.Name)
+		d.Set("type", s.Type)
+		d.Set("resource_type", s.ResourceType)
+		if err := d.Set("exclusion", flattenStandardInclusions(s.Exclusions)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyStandardUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating PagerDuty standard %s", d.Id())
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, err := apiRequest(client, "PUT", "/standards/"+d.Id(), nil, buildStandardStruct(d), nil); err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return resourcePagerDutyStandardRead(d, meta)
+}
+
+func resourcePagerDutyStandardDelete(d *schema.ResourceData, meta interface{}) error {
+	// Standards can't be deleted, the standard keeps its current settings.
+	log.Printf("[INFO] Removing PagerDuty standard %s from the state", d.Id())
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyStandardImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("standard_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,82 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyStandard_Basic(t *testing.T) {
+	description := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	descriptionUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyStandardConfig(description, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStandardExists("pagerduty_standard.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_standard.foo", "description", description),
+					resource.TestCheckResourceAttr(
+						"pagerduty_standard.foo", "active", "true"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyStandardConfig(descriptionUpdated, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStandardExists("pagerduty_standard.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_standard.foo", "description", descriptionUpdated),
+					resource.TestCheckResourceAttr(
+						"pagerduty_standard.foo", "active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyStandardExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No standard ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, err := getStandard(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found == nil {
+			return fmt.Errorf("Standard not found: %v", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyStandardConfig(description string, active bool) string {
+	return fmt.Sprintf(`
+data "pagerduty_standards" "all" {
+  resource_type = "technical_service"
+}
+
+resource "pagerduty_standard" "foo" {
+  standard_id = data.pagerduty_standards.all.standards[0].id
+  active      = %t
+  description = "%s"
+}
+`, active, description)
+}
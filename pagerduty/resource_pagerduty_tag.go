@@ -75,22 +75,25 @@ func resourcePagerDutyTagCreate(d *schema.ResourceData, meta interface{}) error
 		return retryErr
 	}
 
-	return resourcePagerDutyTagRead(d, meta)
+	return fetchPagerDutyTag(d, meta, genError)
 
 }
 
-func resourcePagerDutyTagRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutyTag(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Reading PagerDuty tag %s", d.Id())
-
 	return resource.Retry(30*time.Second, func() *resource.RetryError {
 		if tag, _, err := client.Tags.Get(d.Id()); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if tag != nil {
 			log.Printf("Tag Type: %v", tag.Type)
 			d.Set("label", tag.Label)
@@ -101,6 +104,11 @@ func resourcePagerDutyTagRead(d *schema.ResourceData, meta interface{}) error {
 	})
 }
 
+func resourcePagerDutyTagRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty tag %s", d.Id())
+	return fetchPagerDutyTag(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutyTagDelete(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -3,7 +3,6 @@ package pagerduty
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -60,43 +59,31 @@ func buildTagAssignmentStruct(d *schema.ResourceData) *pagerduty.TagAssignment {
 }
 
 func resourcePagerDutyTagAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
 
 	assignment := buildTagAssignmentStruct(d)
-	assignments := &pagerduty.TagAssignments{
-		Add: []*pagerduty.TagAssignment{assignment},
-	}
 
 	log.Printf("[INFO] Creating PagerDuty tag assignment with tagID %s for %s entity with ID %s", assignment.TagID, assignment.EntityType, assignment.EntityID)
 
-	retryErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := client.Tags.Assign(assignment.EntityType, assignment.EntityID, assignments); err != nil {
-			if isErrCode(err, 400) || isErrCode(err, 429) {
-				return resource.RetryableError(err)
-			}
+	// The tags assigned to the same entity during the apply are sent together
+	if err := config.tagAssignments.add(client, assignment); err != nil {
+		return err
+	}
 
-			return resource.NonRetryableError(err)
-		} else {
-			// create tag_assignment id using the entityID.tagID as PagerDuty API does not return one
-			assignmentID := createAssignmentID(assignment.EntityID, assignment.TagID)
-			d.SetId(assignmentID)
-		}
-		return nil
-	})
+	// create tag_assignment id using the entityID.tagID as PagerDuty API does not return one
+	d.SetId(createAssignmentID(assignment.EntityID, assignment.TagID))
 
-	if retryErr != nil {
-		return retryErr
-	}
 	// give PagerDuty 2 seconds to save the assignment correctly
 	time.Sleep(2 * time.Second)
-	return resourcePagerDutyTagAssignmentRead(d, meta)
+	return fetchPagerDutyTagAssignment(d, meta, genError)
 
 }
 
-func resourcePagerDutyTagAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutyTagAssignment(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
@@ -108,8 +95,13 @@ func resourcePagerDutyTagAssignmentRead(d *schema.ResourceData, meta interface{}
 
 	return resource.Retry(30*time.Second, func() *resource.RetryError {
 		if tagResponse, _, err := client.Tags.ListTagsForEntity(assignment.EntityType, assignment.EntityID); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if tagResponse != nil {
 			var foundTag *pagerduty.Tag
 
@@ -129,42 +121,33 @@ func resourcePagerDutyTagAssignmentRead(d *schema.ResourceData, meta interface{}
 	})
 }
 
+func resourcePagerDutyTagAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	return fetchPagerDutyTagAssignment(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutyTagAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
 
 	assignment := buildTagAssignmentStruct(d)
-	assignments := &pagerduty.TagAssignments{
-		Remove: []*pagerduty.TagAssignment{assignment},
-	}
 	log.Printf("[INFO] Deleting PagerDuty tag assignment with tagID %s for entityID %s", assignment.TagID, assignment.EntityID)
 
-	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
-		if _, err := client.Tags.Assign(assignment.EntityType, assignment.EntityID, assignments); err != nil {
-			if isErrCode(err, 400) || isErrCode(err, 429) {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		} else {
-			d.SetId("")
-		}
-		return nil
-	})
-
-	if retryErr != nil {
-		return retryErr
+	if err := config.tagAssignments.remove(client, assignment); err != nil {
+		return err
 	}
 
+	d.SetId("")
+
 	return nil
 }
 
 func resourcePagerDutyTagAssignmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	ids := strings.Split(d.Id(), ".")
-	if len(ids) != 3 {
-		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_tag_assignment. Expecting an importation ID formed as '<entity_type>.<entity_id>.<tag_id>'")
+	ids, err := parseCompositeImportID("pagerduty_tag_assignment", d.Id(), "entity_type", "entity_id", "tag_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 	entityType, entityID, tagID := ids[0], ids[1], ids[2]
 
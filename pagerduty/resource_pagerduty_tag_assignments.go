@@ -0,0 +1,288 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyTagAssignments() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyTagAssignmentsCreate,
+		Read:   resourcePagerDutyTagAssignmentsRead,
+		Update: resourcePagerDutyTagAssignmentsUpdate,
+		Delete: resourcePagerDutyTagAssignmentsDelete,
+		Schema: map[string]*schema.Schema{
+			"tag_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"entity": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateValueFunc([]string{
+								"users",
+								"teams",
+								"escalation_policies",
+							}),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type tagEntity struct {
+	Type string
+	ID   string
+}
+
+func expandTagEntities(v interface{}) []tagEntity {
+	var entities []tagEntity
+	for _, e := range v.(*schema.Set).List() {
+		entity := e.(map[string]interface{})
+		entities = append(entities, tagEntity{
+			Type: entity["type"].(string),
+			ID:   entity["id"].(string),
+		})
+	}
+
+	return entities
+}
+
+// changeEntityTags adds and removes tags of a single entity in one call to
+// the change_tags endpoint of the entity.
+func changeEntityTags(client *pagerduty.Client, entity tagEntity, add, remove []string) error {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	assignments := &pagerduty.TagAssignments{}
+	for _, id := range add {
+		assignments.Add = append(assignments.Add, &pagerduty.TagAssignment{Type: "tag_reference", TagID: id})
+	}
+	for _, id := range remove {
+		assignments.Remove = append(assignments.Remove, &pagerduty.TagAssignment{Type: "tag_reference", TagID: id})
+	}
+
+	log.Printf("[INFO] Changing tags of %s entity with ID %s: adding %v, removing %v", entity.Type, entity.ID, add, remove)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if _, err := client.Tags.Assign(entity.Type, entity.ID, assignments); err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// listEntityTagIDs returns the IDs of the tags currently assigned to an entity.
+func listEntityTagIDs(client *pagerduty.Client, entity tagEntity) (map[string]bool, error) {
+	resp, _, err := client.Tags.ListTagsForEntity(entity.Type, entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]bool)
+	for _, tag := range resp.Tags {
+		ids[tag.ID] = true
+	}
+
+	return ids, nil
+}
+
+// reconcileTagAssignments makes every entity carry the desired tags, and
+// removes the previously managed tags which are no longer desired.
+func reconcileTagAssignments(client *pagerduty.Client, entities []tagEntity, desired, previous []string) error {
+	for _, entity := range entities {
+		current, err := listEntityTagIDs(client, entity)
+		if err != nil {
+			return err
+		}
+
+		var add, remove []string
+		wanted := make(map[string]bool)
+		for _, id := range desired {
+			wanted[id] = true
+			if !current[id] {
+				add = append(add, id)
+			}
+		}
+		for _, id := range previous {
+			if !wanted[id] && current[id] {
+				remove = append(remove, id)
+			}
+		}
+
+		if err := changeEntityTags(client, entity, add, remove); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourcePagerDutyTagAssignmentsCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	entities := expandTagEntities(d.Get("entity"))
+	tagIDs := expandStringList(d.Get("tag_ids").(*schema.Set).List())
+
+	log.Printf("[INFO] Creating PagerDuty tag assignments of %d tags to %d entities", len(tagIDs), len(entities))
+
+	if err := reconcileTagAssignments(client, entities, tagIDs, nil); err != nil {
+		return err
+	}
+
+	// The PagerDuty API does not return an ID for tag assignments
+	d.SetId(resource.UniqueId())
+
+	// give PagerDuty 2 seconds to save the assignments correctly
+	time.Sleep(2 * time.Second)
+	return resourcePagerDutyTagAssignmentsRead(d, meta)
+}
+
+func resourcePagerDutyTagAssignmentsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty tag assignments %s", d.Id())
+
+	entities := expandTagEntities(d.Get("entity"))
+	tagIDs := expandStringList(d.Get("tag_ids").(*schema.Set).List())
+
+	return resource.Retry(30*time.Second, func() *resource.RetryError {
+		var found []map[string]interface{}
+
+		// Only tags assigned to every entity are reported, so that a tag
+		// missing from a single entity shows up as a diff.
+		assigned := make(map[string]int)
+		for _, entity := range entities {
+			current, err := listEntityTagIDs(client, entity)
+			if err != nil {
+				if isErrCode(err, 404) {
+					log.Printf("[WARN] Removing %s entity with ID %s from tag assignments %s since it's gone", entity.Type, entity.ID, d.Id())
+					continue
+				}
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			found = append(found, map[string]interface{}{
+				"type": entity.Type,
+				"id":   entity.ID,
+			})
+
+			for _, id := range tagIDs {
+				if current[id] {
+					assigned[id]++
+				}
+			}
+		}
+
+		if len(found) == 0 {
+			d.SetId("")
+			return nil
+		}
+
+		var tags []string
+		for _, id := range tagIDs {
+			if assigned[id] == len(found) {
+				tags = append(tags, id)
+			}
+		}
+
+		if err := d.Set("entity", found); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if err := d.Set("tag_ids", tags); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyTagAssignmentsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating PagerDuty tag assignments %s", d.Id())
+
+	oldTags, newTags := d.GetChange("tag_ids")
+	oldEntities, newEntities := d.GetChange("entity")
+
+	previous := expandStringList(oldTags.(*schema.Set).List())
+	desired := expandStringList(newTags.(*schema.Set).List())
+
+	// Entities which are no longer part of the assignments lose every tag
+	for _, e := range oldEntities.(*schema.Set).Difference(newEntities.(*schema.Set)).List() {
+		entity := e.(map[string]interface{})
+		err := changeEntityTags(client, tagEntity{Type: entity["type"].(string), ID: entity["id"].(string)}, nil, previous)
+		if err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	if err := reconcileTagAssignments(client, expandTagEntities(newEntities), desired, previous); err != nil {
+		return err
+	}
+
+	// give PagerDuty 2 seconds to save the assignments correctly
+	time.Sleep(2 * time.Second)
+	return resourcePagerDutyTagAssignmentsRead(d, meta)
+}
+
+func resourcePagerDutyTagAssignmentsDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting PagerDuty tag assignments %s", d.Id())
+
+	tagIDs := expandStringList(d.Get("tag_ids").(*schema.Set).List())
+
+	for _, entity := range expandTagEntities(d.Get("entity")) {
+		if err := changeEntityTags(client, entity, nil, tagIDs); err != nil {
+			if isErrCode(err, 404) {
+				continue
+			}
+			return fmt.Errorf("Error removing tags from %s entity with ID %s: %s", entity.Type, entity.ID, err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
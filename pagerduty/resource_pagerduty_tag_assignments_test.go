@@ -0,0 +1,107 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyTagAssignments_Basic(t *testing.T) {
+	tag1 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	tag2 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team1 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team2 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTagAssignmentsConfig(tag1, tag2, team1, team2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_tag_assignments.foo", "tag_ids.#", "2"),
+					resource.TestCheckResourceAttr("pagerduty_tag_assignments.foo", "entity.#", "2"),
+					testAccCheckPagerDutyTagAssignmentsAssigned("pagerduty_team.foo", "pagerduty_tag.foo"),
+					testAccCheckPagerDutyTagAssignmentsAssigned("pagerduty_team.bar", "pagerduty_tag.bar"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTagAssignmentsConfig(tag1, tag2, team1, team2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_tag_assignments.foo", "tag_ids.#", "1"),
+					resource.TestCheckResourceAttr("pagerduty_tag_assignments.foo", "entity.#", "1"),
+					testAccCheckPagerDutyTagAssignmentsAssigned("pagerduty_team.foo", "pagerduty_tag.foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyTagAssignmentsAssigned(team, tag string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		teamID := s.RootModule().Resources[team].Primary.ID
+		tagID := s.RootModule().Resources[tag].Primary.ID
+
+		ids, err := listEntityTagIDs(client, tagEntity{Type: "teams", ID: teamID})
+		if err != nil {
+			return err
+		}
+
+		if !ids[tagID] {
+			return fmt.Errorf("Tag %s is not assigned to team %s", tagID, teamID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyTagAssignmentsConfig(tag1, tag2, team1, team2 string, reduced bool) string {
+	tags := "[pagerduty_tag.foo.id, pagerduty_tag.bar.id]"
+	entities := `
+  entity {
+    type = "teams"
+    id   = pagerduty_team.foo.id
+  }
+
+  entity {
+    type = "teams"
+    id   = pagerduty_team.bar.id
+  }`
+	if reduced {
+		tags = "[pagerduty_tag.foo.id]"
+		entities = `
+  entity {
+    type = "teams"
+    id   = pagerduty_team.foo.id
+  }`
+	}
+
+	return fmt.Sprintf(`
+resource "pagerduty_tag" "foo" {
+  label = "%s"
+}
+
+resource "pagerduty_tag" "bar" {
+  label = "%s"
+}
+
+resource "pagerduty_team" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_team" "bar" {
+  name = "%s"
+}
+
+resource "pagerduty_tag_assignments" "foo" {
+  tag_ids = %s
+%s
+}
+`, tag1, tag2, team1, team2, tags, entities)
+}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"fmt"
 	"log"
 	"time"
 
@@ -16,17 +17,30 @@ func resourcePagerDutyTeam() *schema.Resource {
 		Update: resourcePagerDutyTeamUpdate,
 		Delete: resourcePagerDutyTeamDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("pagerduty_team", "team", listTeamNames),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(30 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressHTMLEntityDiff,
 			},
 			"description": {
-				Type:     schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "Managed by Terraform",
+				DiffSuppressFunc: suppressHTMLEntityDiff,
+			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  "Managed by Terraform",
+				Default:  false,
 			},
 			"html_url": {
 				Type:     schema.TypeString,
@@ -65,9 +79,33 @@ func resourcePagerDutyTeamCreate(d *schema.ResourceData, meta interface{}) error
 
 	team := buildTeamStruct(d)
 
+	if d.Get("adopt_existing").(bool) {
+		id, err := findExistingByName(client, "team", team.Name, listTeamNames)
+		if err != nil {
+			return err
+		}
+		if id != "" {
+			existing, _, err := client.Teams.Get(id)
+			if err != nil {
+				return err
+			}
+			parent := ""
+			if existing.Parent != nil {
+				parent = existing.Parent.ID
+			}
+			if err := checkAdoptedAttribute("team", team.Name, id, "parent", parent, d.Get("parent").(string)); err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] Adopting existing PagerDuty team %s (%s)", team.Name, id)
+			d.SetId(id)
+			return resourcePagerDutyTeamUpdate(d, meta)
+		}
+	}
+
 	log.Printf("[INFO] Creating PagerDuty team %s", team.Name)
 
-	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		if team, _, err := client.Teams.Create(team); err != nil {
 			return resource.RetryableError(err)
 		} else if team != nil {
@@ -80,31 +118,45 @@ func resourcePagerDutyTeamCreate(d *schema.ResourceData, meta interface{}) error
 		return retryErr
 	}
 
-	return resourcePagerDutyTeamRead(d, meta)
+	return fetchPagerDutyTeam(d, meta, genError)
 
 }
 
-func resourcePagerDutyTeamRead(d *schema.ResourceData, meta interface{}) error {
+func fetchPagerDutyTeam(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Reading PagerDuty team %s", d.Id())
-
-	return resource.Retry(30*time.Second, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		if team, _, err := client.Teams.Get(d.Id()); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if team != nil {
 			d.Set("name", team.Name)
 			d.Set("description", team.Description)
 			d.Set("html_url", team.HTMLURL)
+
+			parent := ""
+			if team.Parent != nil {
+				parent = team.Parent.ID
+			}
+			d.Set("parent", parent)
 		}
 		return nil
 	})
 }
 
+func resourcePagerDutyTeamRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty team %s", d.Id())
+	return fetchPagerDutyTeam(d, meta, handleNotFoundError)
+}
+
 func resourcePagerDutyTeamUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -113,9 +165,19 @@ func resourcePagerDutyTeamUpdate(d *schema.ResourceData, meta interface{}) error
 
 	team := buildTeamStruct(d)
 
+	if err := clearDescription(client, d, "/teams/"+d.Id(), "team"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Updating PagerDuty team %s", d.Id())
 
-	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
+	if d.HasChange("parent") && team.Parent != nil {
+		if err := validateTeamParent(client, d.Id(), team.Parent.ID); err != nil {
+			return err
+		}
+	}
+
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		if _, _, err := client.Teams.Update(d.Id(), team); err != nil {
 			return resource.RetryableError(err)
 		}
@@ -125,9 +187,66 @@ func resourcePagerDutyTeamUpdate(d *schema.ResourceData, meta interface{}) error
 		time.Sleep(2 * time.Second)
 		return retryErr
 	}
+
+	// The parent is omitted from the update payload when it is empty, so
+	// detaching a team from its parent needs an explicit null parent.
+	if d.HasChange("parent") && team.Parent == nil {
+		log.Printf("[INFO] Removing parent from PagerDuty team %s", d.Id())
+
+		retryErr := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := removeTeamParent(client, d.Id()); err != nil {
+				return resource.RetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			time.Sleep(2 * time.Second)
+			return retryErr
+		}
+	}
+
 	return resourcePagerDutyTeamRead(d, meta)
 }
 
+// validateTeamParent walks up the hierarchy from the new parent and makes sure
+// the team being updated is not one of its ancestors, which would otherwise
+// make PagerDuty reject the update half way through a reparenting.
+func validateTeamParent(client *pagerduty.Client, teamID, parentID string) error {
+	seen := make(map[string]bool)
+	for id := parentID; id != ""; {
+		if id == teamID {
+			return fmt.Errorf("Error updating team %s: setting parent %s would create a cycle in the team hierarchy", teamID, parentID)
+		}
+		if seen[id] {
+			break
+		}
+		seen[id] = true
+
+		parent, _, err := client.Teams.Get(id)
+		if err != nil {
+			return err
+		}
+
+		id = ""
+		if parent.Parent != nil {
+			id = parent.Parent.ID
+		}
+	}
+
+	return nil
+}
+
+func removeTeamParent(client *pagerduty.Client, teamID string) error {
+	payload := map[string]interface{}{
+		"team": map[string]interface{}{
+			"parent": nil,
+		},
+	}
+
+	_, err := apiRequest(client, "PUT", "/teams/"+teamID, nil, payload, nil)
+	return err
+}
+
 func resourcePagerDutyTeamDelete(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -136,7 +255,7 @@ func resourcePagerDutyTeamDelete(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[INFO] Deleting PagerDuty team %s", d.Id())
 
-	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if _, err := client.Teams.Delete(d.Id()); err != nil {
 			return resource.RetryableError(err)
 		}
@@ -152,3 +271,28 @@ func resourcePagerDutyTeamDelete(d *schema.ResourceData, meta interface{}) error
 	time.Sleep(time.Second)
 	return nil
 }
+
+func listTeamNames(client *pagerduty.Client, query string) ([]namedObject, error) {
+	o := &pagerduty.ListTeamsOptions{
+		Query: query,
+	}
+
+	var objects []namedObject
+	for {
+		resp, _, err := client.Teams.List(o)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, team := range resp.Teams {
+			objects = append(objects, namedObject{ID: team.ID, Name: team.Name})
+		}
+
+		if !resp.More {
+			break
+		}
+		o.Offset = resp.Offset + resp.Limit
+	}
+
+	return objects, nil
+}
@@ -18,7 +18,13 @@ func resourcePagerDutyTeamMembership() *schema.Resource {
 		Update: resourcePagerDutyTeamMembershipUpdate,
 		Delete: resourcePagerDutyTeamMembershipDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourcePagerDutyTeamMembershipImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"user_id": {
@@ -32,29 +38,63 @@ func resourcePagerDutyTeamMembership() *schema.Resource {
 				ForceNew: true,
 			},
 			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "manager",
+				ValidateFunc: validateTeamRole(),
+			},
+			"effective_role": {
 				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "manager",
-				ValidateFunc: validateValueFunc([]string{
-					"observer",
-					"responder",
-					"manager",
-				}),
+				Computed: true,
 			},
 		},
 	}
 }
 
+// teamRoleRank orders team roles from the least to the most privileged.
+var teamRoleRank = map[string]int{
+	"observer":  0,
+	"responder": 1,
+	"manager":   2,
+}
+
+// maxTeamRoleForUserRole returns the most privileged team role PagerDuty
+// grants to a user with the given base role. Requesting a higher team role
+// succeeds, but the API silently coerces it down to this one.
+func maxTeamRoleForUserRole(userRole string) string {
+	switch userRole {
+	case "read_only_user", "read_only_limited_user":
+		return "observer"
+	case "limited_user":
+		return "responder"
+	default:
+		return "manager"
+	}
+}
+
+// isCoercedTeamRole reports whether actual is the role PagerDuty coerces the
+// configured team role to for a user with the given base role.
+func isCoercedTeamRole(configured, actual, userRole string) bool {
+	max := maxTeamRoleForUserRole(userRole)
+	if teamRoleRank[configured] <= teamRoleRank[max] {
+		return false
+	}
+	return actual == max
+}
+
 func fetchPagerDutyTeamMembership(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
 
 	userID, teamID := resourcePagerDutyTeamMembershipParseID(d.Id())
 	log.Printf("[DEBUG] Reading user: %s from team: %s", userID, teamID)
-	return resource.Retry(2*time.Minute, func() *resource.RetryError {
-		resp, _, err := client.Teams.GetMembers(teamID, &pagerduty.GetMembersOptions{})
+	return resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		// The members of a team are listed once and shared by all of its
+		// memberships.
+		members, err := config.teamMembers.list(client, teamID)
 		if err != nil {
 			errResp := errCallback(err, d)
 			if errResp != nil {
@@ -65,24 +105,40 @@ func fetchPagerDutyTeamMembership(d *schema.ResourceData, meta interface{}, errC
 			return nil
 		}
 
-		for _, member := range resp.Members {
+		for _, member := range members {
 			if member.User.ID == userID {
 				d.Set("user_id", userID)
 				d.Set("team_id", teamID)
+				d.Set("effective_role", member.Role)
+
+				configured := d.Get("role").(string)
+				if configured != "" && configured != member.Role {
+					user, _, err := client.Users.Get(userID, &pagerduty.GetUserOptions{})
+					if err != nil {
+						return resource.RetryableError(err)
+					}
+
+					if isCoercedTeamRole(configured, member.Role, user.Role) {
+						log.Printf("[DEBUG] Team role of user: %s in team: %s was coerced from %s to %s", userID, teamID, configured, member.Role)
+						return nil
+					}
+				}
+
 				d.Set("role", member.Role)
 
 				return nil
 			}
 		}
 
-		log.Printf("[WARN] Removing %s since the user: %s is not a member of: %s", d.Id(), userID, teamID)
+		log.Printf("[DEBUG] User: %s is not a member of: %s", userID, teamID)
 		d.SetId("")
 
 		return nil
 	})
 }
 func resourcePagerDutyTeamMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
@@ -93,7 +149,7 @@ func resourcePagerDutyTeamMembershipCreate(d *schema.ResourceData, meta interfac
 
 	log.Printf("[DEBUG] Adding user: %s to team: %s with role: %s", userID, teamID, role)
 
-	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		if _, err := client.Teams.AddUserWithRole(teamID, userID, role); err != nil {
 			if isErrCode(err, 500) {
 				return resource.RetryableError(err)
@@ -107,6 +163,7 @@ func resourcePagerDutyTeamMembershipCreate(d *schema.ResourceData, meta interfac
 	if retryErr != nil {
 		return retryErr
 	}
+	config.teamMembers.invalidate(teamID)
 
 	d.SetId(fmt.Sprintf("%s:%s", userID, teamID))
 
@@ -118,7 +175,8 @@ func resourcePagerDutyTeamMembershipRead(d *schema.ResourceData, meta interface{
 }
 
 func resourcePagerDutyTeamMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
@@ -130,7 +188,7 @@ func resourcePagerDutyTeamMembershipUpdate(d *schema.ResourceData, meta interfac
 	log.Printf("[DEBUG] Updating user: %s to team: %s with role: %s", userID, teamID, role)
 
 	// To update existing membership resource, We can use the same API as creating a new membership.
-	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		if _, err := client.Teams.AddUserWithRole(teamID, userID, role); err != nil {
 			if isErrCode(err, 500) {
 				return resource.RetryableError(err)
@@ -144,14 +202,16 @@ func resourcePagerDutyTeamMembershipUpdate(d *schema.ResourceData, meta interfac
 	if retryErr != nil {
 		return retryErr
 	}
+	config.teamMembers.invalidate(teamID)
 
 	d.SetId(fmt.Sprintf("%s:%s", userID, teamID))
 
-	return nil
+	return resourcePagerDutyTeamMembershipRead(d, meta)
 }
 
 func resourcePagerDutyTeamMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
@@ -161,7 +221,7 @@ func resourcePagerDutyTeamMembershipDelete(d *schema.ResourceData, meta interfac
 	log.Printf("[DEBUG] Removing user: %s from team: %s", userID, teamID)
 
 	// Retrying to give other resources (such as escalation policies) to delete
-	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if _, err := client.Teams.RemoveUser(teamID, userID); err != nil {
 			if isErrCode(err, 400) {
 				return resource.RetryableError(err)
@@ -175,12 +235,24 @@ func resourcePagerDutyTeamMembershipDelete(d *schema.ResourceData, meta interfac
 		time.Sleep(2 * time.Second)
 		return retryErr
 	}
+	config.teamMembers.invalidate(teamID)
 
 	d.SetId("")
 
 	return nil
 }
 
+func resourcePagerDutyTeamMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseCompositeImportID("pagerduty_team_membership", d.Id(), "user_id", "team_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", ids[0], ids[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourcePagerDutyTeamMembershipParseID(id string) (string, string) {
 	parts := strings.Split(id, ":")
 	return parts[0], parts[1]
@@ -49,6 +49,53 @@ func TestAccPagerDutyTeamMembership_WithRole(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyTeamMembership_CoercedRole(t *testing.T) {
+	user := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamMembershipCoercedRoleConfig(user, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamMembershipExists("pagerduty_team_membership.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team_membership.foo", "role", "manager"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team_membership.foo", "effective_role", "observer"),
+				),
+			},
+			{
+				Config:             testAccCheckPagerDutyTeamMembershipCoercedRoleConfig(user, team),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyTeamMembership_Timeouts(t *testing.T) {
+	user := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamMembershipTimeoutsConfig(user, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamMembershipExists("pagerduty_team_membership.foo"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyTeamMembershipDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -148,3 +195,48 @@ resource "pagerduty_team_membership" "foo" {
 }
 `, user, team, role)
 }
+
+func testAccCheckPagerDutyTeamMembershipCoercedRoleConfig(user, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[1]v@foo.test"
+  role  = "read_only_user"
+}
+
+resource "pagerduty_team" "foo" {
+  name        = "%[2]v"
+  description = "foo"
+}
+
+resource "pagerduty_team_membership" "foo" {
+  user_id = pagerduty_user.foo.id
+  team_id = pagerduty_team.foo.id
+  role    = "manager"
+}
+`, user, team)
+}
+
+func testAccCheckPagerDutyTeamMembershipTimeoutsConfig(user, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[1]v@foo.test"
+}
+
+resource "pagerduty_team" "foo" {
+  name        = "%[2]v"
+  description = "foo"
+}
+
+resource "pagerduty_team_membership" "foo" {
+  user_id = pagerduty_user.foo.id
+  team_id = pagerduty_team.foo.id
+
+  timeouts {
+    create = "5m"
+    delete = "10m"
+  }
+}
+`, user, team)
+}
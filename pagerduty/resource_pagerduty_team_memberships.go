@@ -0,0 +1,247 @@
+package pagerduty
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyTeamMemberships() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyTeamMembershipsCreate,
+		Read:   resourcePagerDutyTeamMembershipsRead,
+		Update: resourcePagerDutyTeamMembershipsUpdate,
+		Delete: resourcePagerDutyTeamMembershipsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyTeamMembershipsImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "manager",
+							ValidateFunc: validateTeamRole(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandTeamMemberships returns the user to role map of a member set.
+func expandTeamMemberships(v interface{}) map[string]string {
+	members := make(map[string]string)
+
+	for _, m := range v.(*schema.Set).List() {
+		member := m.(map[string]interface{})
+		members[member["user_id"].(string)] = member["role"].(string)
+	}
+
+	return members
+}
+
+func flattenTeamMemberships(members []*pagerduty.Member) []interface{} {
+	var result []interface{}
+
+	for _, m := range members {
+		if m.User == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"user_id": m.User.ID,
+			"role":    m.Role,
+		})
+	}
+
+	return result
+}
+
+// reconcileTeamMemberships applies the difference between the old and new
+// user to role maps of a team. Users are added or have their role changed
+// before anyone is removed, so the team never ends up without members
+// part way through a change. The cached members of the team are dropped
+// afterwards, even when only some of the changes were applied, so that the
+// pagerduty_team_membership resources read later in the run see them. Each
+// addition and removal is retried on transient errors until timeout expires.
+func reconcileTeamMemberships(config *Config, client *pagerduty.Client, teamID string, old, new map[string]string, timeout time.Duration) error {
+	defer config.teamMembers.invalidate(teamID)
+
+	for userID, role := range new {
+		if oldRole, ok := old[userID]; ok && oldRole == role {
+			continue
+		}
+
+		log.Printf("[DEBUG] Adding user: %s to team: %s with role: %s", userID, teamID, role)
+
+		retryErr := resource.Retry(timeout, func() *resource.RetryError {
+			if _, err := client.Teams.AddUserWithRole(teamID, userID, role); err != nil {
+				if isErrCode(err, 429) || isErrCode(err, 500) {
+					return resource.RetryableError(err)
+				}
+
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	for userID := range old {
+		if _, ok := new[userID]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Removing user: %s from team: %s", userID, teamID)
+
+		retryErr := resource.Retry(timeout, func() *resource.RetryError {
+			if _, err := client.Teams.RemoveUser(teamID, userID); err != nil {
+				if isErrCode(err, 404) {
+					return nil
+				}
+				if isErrCode(err, 400) || isErrCode(err, 429) {
+					return resource.RetryableError(err)
+				}
+
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+		if retryErr != nil {
+			time.Sleep(2 * time.Second)
+			return retryErr
+		}
+	}
+
+	return nil
+}
+
+func resourcePagerDutyTeamMembershipsCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	teamID := d.Get("team_id").(string)
+
+	resp, _, err := client.Teams.GetMembers(teamID, &pagerduty.GetMembersOptions{})
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]string)
+	for _, m := range resp.Members {
+		if m.User != nil {
+			current[m.User.ID] = m.Role
+		}
+	}
+
+	log.Printf("[INFO] Setting members of PagerDuty team: %s", teamID)
+
+	if err := reconcileTeamMemberships(config, client, teamID, current, expandTeamMemberships(d.Get("member")), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(teamID)
+
+	return resourcePagerDutyTeamMembershipsRead(d, meta)
+}
+
+func resourcePagerDutyTeamMembershipsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading members of PagerDuty team: %s", d.Id())
+
+	return resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		resp, _, err := client.Teams.GetMembers(d.Id(), &pagerduty.GetMembersOptions{})
+		if err != nil {
+			if isErrCode(err, 404) {
+				d.SetId("")
+				return nil
+			}
+
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.Set("team_id", d.Id())
+		if err := d.Set("member", flattenTeamMemberships(resp.Members)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyTeamMembershipsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("member")
+
+	log.Printf("[INFO] Updating members of PagerDuty team: %s", d.Id())
+
+	if err := reconcileTeamMemberships(config, client, d.Id(), expandTeamMemberships(o), expandTeamMemberships(n), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyTeamMembershipsRead(d, meta)
+}
+
+func resourcePagerDutyTeamMembershipsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Removing all members of PagerDuty team: %s", d.Id())
+
+	if err := reconcileTeamMemberships(config, client, d.Id(), expandTeamMemberships(d.Get("member")), map[string]string{}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyTeamMembershipsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("team_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
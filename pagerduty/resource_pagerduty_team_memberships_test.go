@@ -0,0 +1,221 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccPagerDutyTeamMemberships_Basic(t *testing.T) {
+	user1 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	user2 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamMembershipsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamMembershipsConfig(user1, user2, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamMembershipsCount("pagerduty_team_memberships.foo", 2),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team_memberships.foo", "member.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamMembershipsConfigUpdated(user1, user2, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamMembershipsCount("pagerduty_team_memberships.foo", 1),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team_memberships.foo", "member.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyTeamMemberships_Timeouts(t *testing.T) {
+	user1 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	user2 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamMembershipsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamMembershipsTimeoutsConfig(user1, user2, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamMembershipsCount("pagerduty_team_memberships.foo", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyTeamMembershipsDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_team_memberships" {
+			continue
+		}
+
+		resp, _, err := client.Teams.GetMembers(r.Primary.ID, &pagerduty.GetMembersOptions{})
+		if err == nil && len(resp.Members) > 0 {
+			return fmt.Errorf("Team %s still has %d members", r.Primary.ID, len(resp.Members))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckPagerDutyTeamMembershipsCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		resp, _, err := client.Teams.GetMembers(rs.Primary.ID, &pagerduty.GetMembersOptions{})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Members) != count {
+			return fmt.Errorf("Expected team %s to have %d members, got %d", rs.Primary.ID, count, len(resp.Members))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyTeamMembershipsConfig(user1, user2, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[1]v@foo.test"
+}
+
+resource "pagerduty_user" "bar" {
+  name  = "%[2]v"
+  email = "%[2]v@foo.test"
+}
+
+resource "pagerduty_team" "foo" {
+  name        = "%[3]v"
+  description = "foo"
+}
+
+resource "pagerduty_team_memberships" "foo" {
+  team_id = pagerduty_team.foo.id
+
+  member {
+    user_id = pagerduty_user.foo.id
+    role    = "manager"
+  }
+
+  member {
+    user_id = pagerduty_user.bar.id
+    role    = "responder"
+  }
+}
+`, user1, user2, team)
+}
+
+func testAccCheckPagerDutyTeamMembershipsConfigUpdated(user1, user2, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[1]v@foo.test"
+}
+
+resource "pagerduty_user" "bar" {
+  name  = "%[2]v"
+  email = "%[2]v@foo.test"
+}
+
+resource "pagerduty_team" "foo" {
+  name        = "%[3]v"
+  description = "foo"
+}
+
+resource "pagerduty_team_memberships" "foo" {
+  team_id = pagerduty_team.foo.id
+
+  member {
+    user_id = pagerduty_user.bar.id
+    role    = "observer"
+  }
+}
+`, user1, user2, team)
+}
+
+func TestReconcileTeamMembershipsInvalidatesMembers(t *testing.T) {
+	config := &Config{}
+	config.teamMembers.teams = map[string]*teamMembersListing{
+		"PTEAM":  {done: make(chan struct{})},
+		"POTHER": {done: make(chan struct{})},
+	}
+
+	// Without any change to apply, no request is made
+	if err := reconcileTeamMemberships(config, nil, "PTEAM", map[string]string{}, map[string]string{}, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := config.teamMembers.teams["PTEAM"]; ok {
+		t.Errorf("expected the members of the reconciled team to be dropped from the cache")
+	}
+	if _, ok := config.teamMembers.teams["POTHER"]; !ok {
+		t.Errorf("expected the members of the other teams to stay cached")
+	}
+}
+
+func testAccCheckPagerDutyTeamMembershipsTimeoutsConfig(user1, user2, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[1]v@foo.test"
+}
+
+resource "pagerduty_user" "bar" {
+  name  = "%[2]v"
+  email = "%[2]v@foo.test"
+}
+
+resource "pagerduty_team" "foo" {
+  name = "%[3]v"
+}
+
+resource "pagerduty_team_memberships" "foo" {
+  team_id = pagerduty_team.foo.id
+
+  member {
+    user_id = pagerduty_user.foo.id
+  }
+
+  member {
+    user_id = pagerduty_user.bar.id
+    role    = "responder"
+  }
+
+  timeouts {
+    create = "5m"
+    delete = "10m"
+  }
+}
+`, user1, user2, team)
+}
@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -87,6 +88,158 @@ func TestAccPagerDutyTeam_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyTeam_DeletedOutOfBand(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamConfig(team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamExists("pagerduty_team.foo"),
+					testAccCheckPagerDutyTeamDeleteOutOfBand("pagerduty_team.foo"),
+				),
+				// The refresh removes the team from the state, so it's planned again
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckPagerDutyTeamDeleteOutOfBand deletes the team as a user would
+// in the web app.
+func testAccCheckPagerDutyTeamDeleteOutOfBand(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		_, err := client.Teams.Delete(rs.Primary.ID)
+		return err
+	}
+}
+
+func TestFetchPagerDutyTeam_NotFoundAfterCreate(t *testing.T) {
+	var requests int
+	config := testStubbedConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests++; requests == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"message":"Not Found","code":2100}}`))
+			return
+		}
+		w.Write([]byte(`{"team":{"id":"PTEAM","name":"foo"}}`))
+	})
+
+	d := resourcePagerDutyTeam().TestResourceData()
+	d.SetId("PTEAM")
+
+	// Right after the team is created, a 404 is retried until it's found.
+	if err := fetchPagerDutyTeam(d, config, genError); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "PTEAM" || d.Get("name").(string) != "foo" {
+		t.Errorf("expected the created team to be read after the 404, got %q named %q", d.Id(), d.Get("name"))
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestAccPagerDutyTeam_AdoptExisting(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	var existingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client, _ := testAccProvider.Meta().(*Config).Client()
+					existing, _, err := client.Teams.Create(&pagerduty.Team{Name: team, Description: "created outside Terraform"})
+					if err != nil {
+						t.Fatal(err)
+					}
+					existingID = existing.ID
+				},
+				Config: testAccCheckPagerDutyTeamAdoptExistingConfig(team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamExists("pagerduty_team.foo"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["pagerduty_team.foo"].Primary.ID; id != existingID {
+							return fmt.Errorf("Expected the existing team %s to be adopted, got %s", existingID, id)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "description", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourcePagerDutyTeamCreate_AdoptMismatch(t *testing.T) {
+	config := testStubbedConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/teams":
+			w.Write([]byte(`{"teams":[{"id":"PTEAM","name":"foo"}],"more":false}`))
+		case r.Method == "GET" && r.URL.Path == "/teams/PTEAM":
+			w.Write([]byte(`{"team":{"id":"PTEAM","name":"foo","parent":{"id":"PPARENT","type":"team_reference"}}}`))
+		default:
+			t.Errorf("expected the team not to be created or updated, got %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	d := resourcePagerDutyTeam().TestResourceData()
+	d.Set("name", "foo")
+	d.Set("adopt_existing", true)
+
+	err := resourcePagerDutyTeamCreate(d, config)
+	if err == nil || !strings.Contains(err.Error(), `as parent doesn't match: "PPARENT" in PagerDuty, "" in the configuration`) {
+		t.Errorf("expected the team of another parent not to be adopted, got %v", err)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected no team in the state, got %s", d.Id())
+	}
+}
+
+func TestAccPagerDutyTeam_EmptyDescription(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamConfig(team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamExists("pagerduty_team.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "description", "foo"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamEmptyDescriptionConfig(team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamExists("pagerduty_team.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "description", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyTeam_Parent(t *testing.T) {
 	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	parent := fmt.Sprintf("tf-%s", acctest.RandString(5))
@@ -117,6 +270,41 @@ func TestAccPagerDutyTeam_Parent(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyTeam_Reparent(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	parent := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	otherParent := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamReparentConfig(team, parent, otherParent, "pagerduty_team.parent.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_team.foo", "parent", "pagerduty_team.parent", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamReparentConfig(team, parent, otherParent, "pagerduty_team.other_parent.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_team.foo", "parent", "pagerduty_team.other_parent", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamReparentConfig(team, parent, otherParent, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "parent", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyTeamDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -173,3 +361,40 @@ resource "pagerduty_team" "foo" {
 	parent = pagerduty_team.parent.id
 }`, parent, team)
 }
+
+func testAccCheckPagerDutyTeamReparentConfig(team, parent, otherParent, parentRef string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "parent" {
+  name        = "%s"
+  description = "parent"
+}
+
+resource "pagerduty_team" "other_parent" {
+  name        = "%s"
+  description = "other parent"
+}
+
+resource "pagerduty_team" "foo" {
+  name        = "%s"
+  description = "foo"
+  parent      = %s
+}`, parent, otherParent, team, parentRef)
+}
+
+func testAccCheckPagerDutyTeamAdoptExistingConfig(team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name           = "%s"
+  description    = "foo"
+  adopt_existing = true
+}
+`, team)
+}
+
+func testAccCheckPagerDutyTeamEmptyDescriptionConfig(team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name        = "%s"
+  description = ""
+}`, team)
+}
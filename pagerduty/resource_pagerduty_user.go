@@ -1,11 +1,13 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -17,43 +19,47 @@ func resourcePagerDutyUser() *schema.Resource {
 		Read:   resourcePagerDutyUserRead,
 		Update: resourcePagerDutyUserUpdate,
 		Delete: resourcePagerDutyUserDelete,
+		CustomizeDiff: customdiff.All(
+			checkUserRoleAssignable,
+			checkPagerDutyUserLicenseOverage,
+		),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourcePagerDutyUserImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 				// Suppress the diff shown if there are leading or trailing spaces
-				DiffSuppressFunc: suppressLeadTrailSpaceDiff,
+				DiffSuppressFunc: suppressExternallyManagedDiff(suppressLeadTrailSpaceDiff),
 			},
 
 			"email": {
 				Type:             schema.TypeString,
 				Required:         true,
-				DiffSuppressFunc: suppressCaseDiff,
+				ValidateFunc:     validateEmail,
+				DiffSuppressFunc: suppressExternallyManagedDiff(suppressCaseDiff),
 			},
 
 			"color": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateUserColor(),
 			},
 
 			"role": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "user",
-				ValidateFunc: validateValueFunc([]string{
-					"admin",
-					"limited_user",
-					"observer",
-					"owner",
-					"read_only_user",
-					"restricted_access",
-					"read_only_limited_user",
-					"user",
-				}),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "user",
+				ValidateFunc:     validateUserRole(),
+				DiffSuppressFunc: suppressExternallyManagedDiff(nil),
 			},
 
 			"job_title": {
@@ -77,19 +83,22 @@ func resourcePagerDutyUser() *schema.Resource {
 				Set: schema.HashString,
 			},
 
-			"time_zone": {
-				Type:     schema.TypeString,
-				Optional: true,
+			"team_roles": {
+				Type:     schema.TypeMap,
 				Computed: true,
-				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-					_, err := time.LoadLocation(val.(string))
-					if err != nil {
-						errs = append(errs, err)
-					}
-					return
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
 				},
 			},
 
+			"time_zone": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateTimeZone,
+				DiffSuppressFunc: suppressTimeZoneDiff,
+			},
+
 			"html_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -105,10 +114,99 @@ func resourcePagerDutyUser() *schema.Resource {
 				Optional: true,
 				Default:  "Managed by Terraform",
 			},
+
+			"license": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"on_destroy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "delete",
+				ValidateFunc: validateValueFunc([]string{
+					"delete",
+					"reassign",
+				}),
+			},
+
+			"fallback_user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"externally_managed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
+// suppressExternallyManagedDiff suppresses any diff on the identity fields of
+// an existing user whose identity is managed outside of Terraform (e.g. by
+// SCIM provisioning), and otherwise defers to the given suppress function.
+func suppressExternallyManagedDiff(f schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if d.Id() != "" && d.Get("externally_managed").(bool) {
+			return true
+		}
+
+		return f != nil && f(k, old, new, d)
+	}
+}
+
+// checkUserRoleAssignable rejects giving the owner role to a user, which the
+// API refuses: the account owner can only be changed by the current owner in
+// the web app. The owner keeps its role when it's managed or imported.
+func checkUserRoleAssignable(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("role") {
+		return nil
+	}
+
+	if o, n := diff.GetChange("role"); n.(string) == "owner" && o.(string) != "owner" {
+		return fmt.Errorf("role: the owner role can't be assigned through the PagerDuty API. The account owner can only be changed by the current owner in the PagerDuty web app, after which the user can be imported with role \"owner\"")
+	}
+	return nil
+}
+
+// checkPagerDutyUserLicenseOverage verifies at plan time that the account has
+// enough license allocations left for the users being created.
+func checkPagerDutyUserLicenseOverage(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*Config)
+	if config.LicenseOverageCheck == "off" || diff.Id() != "" {
+		return nil
+	}
+
+	if !diff.NewValueKnown("role") || !diff.NewValueKnown("license") {
+		return nil
+	}
+
+	key := diff.Get("email").(string)
+	if !diff.NewValueKnown("email") || key == "" {
+		return nil
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	err = config.plannedLicenses.plan(client, strings.ToLower(key), diff.Get("license").(string), diff.Get("role").(string))
+	if err != nil {
+		// Accounts without the Licenses API can't be checked
+		if isErrCode(err, 403) || isErrCode(err, 404) {
+			return nil
+		}
+
+		return fmt.Errorf("Error planning user %s: %s", key, err)
+	}
+
+	return nil
+}
+
 func buildUserStruct(d *schema.ResourceData) *pagerduty.User {
 	user := &pagerduty.User{
 		Name:  strings.TrimSpace(d.Get("name").(string)),
@@ -159,14 +257,15 @@ func resourcePagerDutyUserCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[INFO] pooh Reading PagerDuty user %s", d.Id())
 
-	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		user, _, err := client.Users.Get(d.Id(), &pagerduty.GetUserOptions{})
 		if err != nil {
 			errResp := handleNotFoundError(err, d)
@@ -194,24 +293,62 @@ func resourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error {
 			)
 		}
 
+		var teamIDs []string
+		for _, t := range user.Teams {
+			teamIDs = append(teamIDs, t.ID)
+		}
+
+		teamRoles, err := fetchUserTeamRoles(config, client, d.Id(), teamIDs)
+		if err != nil {
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		}
+		d.Set("team_roles", teamRoles)
+
 		d.Set("invitation_sent", user.InvitationSent)
 
+		userLicense, err := getUserLicense(client, d.Id())
+		if err != nil {
+			// Accounts without the Licenses API don't expose the license of a user
+			if isErrCode(err, 403) || isErrCode(err, 404) {
+				return nil
+			}
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		}
+		if userLicense != nil {
+			d.Set("license", userLicense.ID)
+		}
+
 		return nil
 	})
 }
 
 func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
 
 	user := buildUserStruct(d)
 
+	// Identity fields of externally managed users are never sent, so that
+	// Terraform doesn't overwrite what the identity provider set.
+	if d.Get("externally_managed").(bool) {
+		user.Name = ""
+		user.Email = ""
+		user.Role = ""
+	}
+
+	if err := clearDescription(client, d, "/users/"+d.Id(), "user"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Updating PagerDuty user %s", d.Id())
 
 	// Retrying to give other resources (such as escalation policies) to delete
-	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(createOrUpdateTimeout(d), func() *resource.RetryError {
 		if _, _, err := client.Users.Update(d.Id(), user); err != nil {
 			if isErrCode(err, 400) {
 				return resource.RetryableError(err)
@@ -226,6 +363,23 @@ func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error
 		return retryErr
 	}
 
+	if licenseID := d.Get("license").(string); d.HasChange("license") && licenseID != "" {
+		licenses, err := listLicenses(client)
+		if err != nil {
+			return err
+		}
+
+		if err := validateLicenseRole(licenses, licenseID, d.Get("role").(string)); err != nil {
+			return fmt.Errorf("Error assigning license to user %s: %s", d.Id(), err)
+		}
+
+		log.Printf("[INFO] Assigning license %s to PagerDuty user %s", licenseID, d.Id())
+
+		if err := assignUserLicense(client, d.Id(), licenseID); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("teams") {
 		o, n := d.GetChange("teams")
 
@@ -252,7 +406,11 @@ func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error
 
 			log.Printf("[INFO] Removing PagerDuty user %s from team: %s", d.Id(), t)
 
-			if _, err := client.Teams.RemoveUser(t, d.Id()); err != nil {
+			// The cached members of the team, read for team_roles and team
+			// memberships, are stale after the change, even a failed one.
+			_, err := client.Teams.RemoveUser(t, d.Id())
+			config.teamMembers.invalidate(t)
+			if err != nil {
 				return err
 			}
 		}
@@ -260,7 +418,9 @@ func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error
 		for _, t := range add {
 			log.Printf("[INFO] Adding PagerDuty user %s to team: %s", d.Id(), t)
 
-			if _, err := client.Teams.AddUser(t, d.Id()); err != nil {
+			_, err := client.Teams.AddUser(t, d.Id())
+			config.teamMembers.invalidate(t)
+			if err != nil {
 				return err
 			}
 		}
@@ -275,10 +435,16 @@ func resourcePagerDutyUserDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if d.Get("on_destroy").(string) == "reassign" {
+		if err := offboardPagerDutyUser(client, d.Id(), d.Get("fallback_user_id").(string)); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty user %s", d.Id())
 
 	// Retrying to give other resources (such as escalation policies) to delete
-	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if _, err := client.Users.Delete(d.Id()); err != nil {
 			if isErrCode(err, 400) {
 				return resource.RetryableError(err)
@@ -299,3 +465,186 @@ func resourcePagerDutyUserDelete(d *schema.ResourceData, meta interface{}) error
 	time.Sleep(time.Second)
 	return nil
 }
+
+// fetchUserTeamRoles returns the role of the user in each of the given teams,
+// keyed by team ID. Team roles are only exposed through team memberships, so
+// the members of each team are read from the listings shared with the
+// pagerduty_team_membership resources during the run.
+func fetchUserTeamRoles(config *Config, client *pagerduty.Client, userID string, teamIDs []string) (map[string]string, error) {
+	roles := make(map[string]string)
+
+	for _, teamID := range teamIDs {
+		members, err := config.teamMembers.list(client, teamID)
+		if err != nil {
+			if isErrCode(err, 404) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, m := range members {
+			if m.User != nil && m.User.ID == userID {
+				roles[teamID] = m.Role
+				break
+			}
+		}
+	}
+
+	return roles, nil
+}
+
+func resourcePagerDutyUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("on_destroy", "delete")
+	d.Set("externally_managed", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// offboardPagerDutyUser removes every reference to the user from escalation
+// policies and schedule layers, replacing it with the fallback user if one is
+// given. PagerDuty refuses to delete users which are still referenced.
+func offboardPagerDutyUser(client *pagerduty.Client, userID, fallbackID string) error {
+	if err := offboardPagerDutyUserFromEscalationPolicies(client, userID, fallbackID); err != nil {
+		return err
+	}
+
+	return offboardPagerDutyUserFromSchedules(client, userID, fallbackID)
+}
+
+func offboardPagerDutyUserFromEscalationPolicies(client *pagerduty.Client, userID, fallbackID string) error {
+	var policyIDs []string
+
+	o := &pagerduty.ListEscalationPoliciesOptions{UserIDs: []string{userID}}
+	for {
+		resp, _, err := client.EscalationPolicies.List(o)
+		if err != nil {
+			return err
+		}
+
+		for _, ep := range resp.EscalationPolicies {
+			policyIDs = append(policyIDs, ep.ID)
+		}
+
+		if !resp.More {
+			break
+		}
+		o.Offset = resp.Offset + resp.Limit
+	}
+
+	for _, id := range policyIDs {
+		policy, _, err := client.EscalationPolicies.Get(id, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			return err
+		}
+
+		var rules []*pagerduty.EscalationRule
+		for _, rule := range policy.EscalationRules {
+			rule.Targets = replaceUserInEscalationTargets(rule.Targets, userID, fallbackID)
+			if len(rule.Targets) > 0 {
+				rules = append(rules, rule)
+			}
+		}
+
+		if len(rules) == 0 {
+			return fmt.Errorf("Error offboarding user %s: user is the only target of escalation policy %s, please set fallback_user_id", userID, policy.ID)
+		}
+		policy.EscalationRules = rules
+
+		log.Printf("[INFO] Removing PagerDuty user %s from escalation policy %s", userID, policy.ID)
+
+		if _, _, err := client.EscalationPolicies.Update(policy.ID, policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func offboardPagerDutyUserFromSchedules(client *pagerduty.Client, userID, fallbackID string) error {
+	var scheduleIDs []string
+
+	o := &pagerduty.ListSchedulesOptions{}
+	for {
+		resp, _, err := client.Schedules.List(o)
+		if err != nil {
+			return err
+		}
+
+		for _, schedule := range resp.Schedules {
+			for _, u := range schedule.Users {
+				if u.ID == userID {
+					scheduleIDs = append(scheduleIDs, schedule.ID)
+					break
+				}
+			}
+		}
+
+		if !resp.More {
+			break
+		}
+		o.Offset = resp.Offset + resp.Limit
+	}
+
+	for _, id := range scheduleIDs {
+		schedule, _, err := client.Schedules.Get(id, &pagerduty.GetScheduleOptions{})
+		if err != nil {
+			return err
+		}
+
+		for _, layer := range schedule.ScheduleLayers {
+			layer.Users = replaceUserInScheduleLayer(layer.Users, userID, fallbackID)
+			if len(layer.Users) == 0 {
+				return fmt.Errorf("Error offboarding user %s: user is the only member of a layer of schedule %s, please set fallback_user_id", userID, schedule.ID)
+			}
+		}
+
+		log.Printf("[INFO] Removing PagerDuty user %s from schedule %s", userID, schedule.ID)
+
+		if _, _, err := client.Schedules.Update(schedule.ID, schedule, &pagerduty.UpdateScheduleOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replaceUserInEscalationTargets(targets []*pagerduty.EscalationTargetReference, userID, fallbackID string) []*pagerduty.EscalationTargetReference {
+	var result []*pagerduty.EscalationTargetReference
+	seen := make(map[string]bool)
+
+	for _, t := range targets {
+		if t.ID == userID {
+			if fallbackID == "" {
+				continue
+			}
+			t = &pagerduty.EscalationTargetReference{ID: fallbackID, Type: "user_reference"}
+		}
+
+		// Avoid targeting the fallback user twice in the same rule.
+		if seen[t.Type+t.ID] {
+			continue
+		}
+		seen[t.Type+t.ID] = true
+
+		result = append(result, t)
+	}
+
+	return result
+}
+
+func replaceUserInScheduleLayer(users []*pagerduty.UserReferenceWrapper, userID, fallbackID string) []*pagerduty.UserReferenceWrapper {
+	var result []*pagerduty.UserReferenceWrapper
+
+	for _, u := range users {
+		if u.User != nil && u.User.ID == userID {
+			if fallbackID == "" {
+				continue
+			}
+			u = &pagerduty.UserReferenceWrapper{User: &pagerduty.UserReference{ID: fallbackID, Type: "user_reference"}}
+		}
+
+		result = append(result, u)
+	}
+
+	return result
+}
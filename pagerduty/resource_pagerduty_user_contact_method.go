@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
@@ -23,19 +24,7 @@ func resourcePagerDutyUserContactMethod() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyUserContactMethodImport,
 		},
-		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
-			a := diff.Get("address").(string)
-			t := diff.Get("type").(string)
-			if t == "sms_contact_method" || t == "phone_contact_method" {
-				if strings.HasPrefix(a, "0") {
-					return errors.New("phone numbers starting with a 0 are not supported")
-				}
-				if _, err := strconv.Atoi(a); err != nil {
-					return errors.New("phone numbers should only contain digits")
-				}
-			}
-			return nil
-		},
+		CustomizeDiff: validateUserContactMethodAddress,
 		Schema: map[string]*schema.Schema{
 			"user_id": {
 				Type:     schema.TypeString,
@@ -60,8 +49,9 @@ func resourcePagerDutyUserContactMethod() *schema.Resource {
 			},
 
 			"country_code": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 999),
 			},
 
 			"enabled": {
@@ -80,13 +70,107 @@ func resourcePagerDutyUserContactMethod() *schema.Resource {
 			},
 
 			"address": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressContactMethodAddressDiff,
 			},
 		},
 	}
 }
 
+func isPhoneContactMethod(t string) bool {
+	return t == "phone_contact_method" || t == "sms_contact_method"
+}
+
+// normalizePhoneNumber returns the number the way PagerDuty stores it: only
+// digits, without the country code, and without the leading 0 used to dial
+// numbers within most countries, which PagerDuty strips. Numbers of the North
+// American Numbering Plan never start with a 0, so they keep it and fail
+// validation instead.
+func normalizePhoneNumber(address string, countryCode int) string {
+	if countryCode == 0 {
+		countryCode = 1
+	}
+	cc := strconv.Itoa(countryCode)
+
+	n := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, address)
+
+	switch {
+	case strings.HasPrefix(n, "+"+cc):
+		n = strings.TrimPrefix(n, "+"+cc)
+	case strings.HasPrefix(n, "00"+cc) && countryCode != 1:
+		n = strings.TrimPrefix(n, "00"+cc)
+	}
+
+	if countryCode != 1 {
+		n = strings.TrimLeft(n, "0")
+	}
+	return n
+}
+
+// validateUserContactMethodAddress checks that the addresses of email
+// contact methods are valid email addresses, and that the numbers of phone
+// and SMS contact methods can be called once normalized: they must be digits
+// only, and no longer than the 15 digits of international numbers, country
+// code included. Numbers of the North American Numbering Plan must be 10
+// digits long and can't start with a 0.
+func validateUserContactMethodAddress(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("type").(string) == "email_contact_method" && diff.NewValueKnown("address") {
+		if _, errs := validateEmail(diff.Get("address"), "address"); len(errs) > 0 {
+			return errs[0]
+		}
+		return nil
+	}
+
+	if !isPhoneContactMethod(diff.Get("type").(string)) || !diff.NewValueKnown("address") || !diff.NewValueKnown("country_code") {
+		return nil
+	}
+
+	countryCode := diff.Get("country_code").(int)
+	if countryCode == 0 {
+		countryCode = 1
+	}
+	n := normalizePhoneNumber(diff.Get("address").(string), countryCode)
+
+	if _, err := strconv.ParseUint(n, 10, 64); err != nil {
+		return errors.New("phone numbers should only contain digits")
+	}
+	if countryCode == 1 {
+		if strings.HasPrefix(n, "0") {
+			return errors.New("phone numbers starting with a 0 are not supported")
+		}
+		if len(n) != 10 {
+			return fmt.Errorf("phone numbers with the country code 1 should have 10 digits, got %d in %s", len(n), n)
+		}
+		return nil
+	}
+
+	if max := 15 - len(strconv.Itoa(countryCode)); len(n) < 4 || len(n) > max {
+		return fmt.Errorf("phone numbers with the country code %d should have between 4 and %d digits once the leading 0 is removed, got %d in %s", countryCode, max, len(n), n)
+	}
+	return nil
+}
+
+// suppressContactMethodAddressDiff hides the diff between the configured
+// address and the one stored by PagerDuty when they're the same phone number
+// once normalized, or the same email address ignoring case.
+func suppressContactMethodAddressDiff(k, old, new string, d *schema.ResourceData) bool {
+	switch t := d.Get("type").(string); {
+	case isPhoneContactMethod(t):
+		countryCode := d.Get("country_code").(int)
+		return normalizePhoneNumber(old, countryCode) == normalizePhoneNumber(new, countryCode)
+	case t == "email_contact_method":
+		return strings.EqualFold(old, new)
+	}
+	return false
+}
+
 func buildUserContactMethodStruct(d *schema.ResourceData) *pagerduty.ContactMethod {
 	contactMethod := &pagerduty.ContactMethod{
 		Type:    d.Get("type").(string),
@@ -94,6 +178,10 @@ func buildUserContactMethodStruct(d *schema.ResourceData) *pagerduty.ContactMeth
 		Address: d.Get("address").(string),
 	}
 
+	if isPhoneContactMethod(contactMethod.Type) {
+		contactMethod.Address = normalizePhoneNumber(contactMethod.Address, d.Get("country_code").(int))
+	}
+
 	if v, ok := d.GetOk("send_short_email"); ok {
 		contactMethod.SendShortEmail = v.(bool)
 	}
@@ -209,10 +297,9 @@ func resourcePagerDutyUserContactMethodImport(d *schema.ResourceData, meta inter
 		return []*schema.ResourceData{}, err
 	}
 
-	ids := strings.Split(d.Id(), ":")
-
-	if len(ids) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_user_contact_method. Expecting an ID formed as '<user_id>:<contact_method_id>'")
+	ids, err := parseCompositeImportID("pagerduty_user_contact_method", d.Id(), "user_id", "contact_method_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 	uid, id := ids[0], ids[1]
 
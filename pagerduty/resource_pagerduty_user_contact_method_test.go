@@ -68,6 +68,54 @@ func TestAccPagerDutyUserContactMethodPhone_Basic(t *testing.T) {
 	})
 }
 
+func TestNormalizePhoneNumber(t *testing.T) {
+	cases := []struct {
+		address     string
+		countryCode int
+		expected    string
+	}{
+		{"4153013250", 1, "4153013250"},
+		{"(415) 301-3250", 1, "4153013250"},
+		{"+14153013250", 1, "4153013250"},
+		{"04153013250", 1, "04153013250"},
+		{"07700 900123", 44, "7700900123"},
+		{"+44 7700 900123", 44, "7700900123"},
+		{"0044 7700 900123", 44, "7700900123"},
+		{"030 1234567", 49, "301234567"},
+	}
+
+	for _, c := range cases {
+		if got := normalizePhoneNumber(c.address, c.countryCode); got != c.expected {
+			t.Errorf("%s with country code %d: expected %s, got %s", c.address, c.countryCode, c.expected, got)
+		}
+	}
+}
+
+func TestAccPagerDutyUserContactMethodPhone_LeadingZero(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserContactMethodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserContactMethodPhoneCountryConfig(username, email, "44", "07700 900123"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserContactMethodExists("pagerduty_user_contact_method.foo"),
+					resource.TestCheckResourceAttr("pagerduty_user_contact_method.foo", "address", "7700900123"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyUserContactMethodPhoneCountryConfig(username, email, "44", "0770"),
+				ExpectError: regexp.MustCompile("should have between 4 and 13 digits"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyUserContactMethodSMS_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	usernameUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
@@ -238,3 +286,20 @@ resource "pagerduty_user_contact_method" "foo" {
 }
 `, username, email)
 }
+
+func testAccCheckPagerDutyUserContactMethodPhoneCountryConfig(username, email, countryCode, phone string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[2]v"
+}
+
+resource "pagerduty_user_contact_method" "foo" {
+  user_id      = pagerduty_user.foo.id
+  type         = "phone_contact_method"
+  country_code = "%[3]s"
+  address      = "%[4]s"
+  label        = "%[1]v"
+}
+`, username, email, countryCode, phone)
+}
@@ -0,0 +1,222 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// handoffNotificationRule represents an on-call handoff notification rule,
+// which notifies a user before they go on or off call.
+type handoffNotificationRule struct {
+	ID                     string                            `json:"id,omitempty"`
+	Type                   string                            `json:"type,omitempty"`
+	HandoffType            string                            `json:"handoff_type,omitempty"`
+	NotifyAdvanceInMinutes int                               `json:"notify_advance_in_minutes"`
+	ContactMethod          *pagerduty.ContactMethodReference `json:"contact_method,omitempty"`
+}
+
+type handoffNotificationRulePayload struct {
+	Rule *handoffNotificationRule `json:"oncall_handoff_notification_rule"`
+}
+
+func handoffNotificationRulesPath(userID string) string {
+	return fmt.Sprintf("/users/%s/oncall_handoff_notification_rules", userID)
+}
+
+func resourcePagerDutyUserHandoffNotificationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyUserHandoffNotificationRuleCreate,
+		Read:   resourcePagerDutyUserHandoffNotificationRuleRead,
+		Update: resourcePagerDutyUserHandoffNotificationRuleUpdate,
+		Delete: resourcePagerDutyUserHandoffNotificationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyUserHandoffNotificationRuleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"handoff_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "both",
+				ValidateFunc: validateValueFunc([]string{
+					"both",
+					"oncall",
+					"offcall",
+				}),
+			},
+			"notify_advance_in_minutes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"contact_method": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateValueFunc([]string{
+								"email_contact_method",
+								"phone_contact_method",
+								"push_notification_contact_method",
+								"sms_contact_method",
+							}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildUserHandoffNotificationRuleStruct(d *schema.ResourceData) *handoffNotificationRule {
+	rule := &handoffNotificationRule{
+		Type:                   "oncall_handoff_notification_rule",
+		HandoffType:            d.Get("handoff_type").(string),
+		NotifyAdvanceInMinutes: d.Get("notify_advance_in_minutes").(int),
+	}
+
+	if cms := d.Get("contact_method").([]interface{}); len(cms) > 0 && cms[0] != nil {
+		cm := cms[0].(map[string]interface{})
+		rule.ContactMethod = &pagerduty.ContactMethodReference{
+			ID:   cm["id"].(string),
+			Type: cm["type"].(string),
+		}
+	}
+
+	return rule
+}
+
+func fetchPagerDutyUserHandoffNotificationRule(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	userID := d.Get("user_id").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		v := new(handoffNotificationRulePayload)
+		if _, err := apiRequest(client, "GET", handoffNotificationRulesPath(userID)+"/"+d.Id(), nil, nil, v); err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("handoff_type", v.Rule.HandoffType)
+		d.Set("notify_advance_in_minutes", v.Rule.NotifyAdvanceInMinutes)
+
+		if v.Rule.ContactMethod != nil {
+			if err := d.Set("contact_method", []interface{}{flattenContactMethod(v.Rule.ContactMethod)}); err != nil {
+				return resource.NonRetryableError(err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyUserHandoffNotificationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	userID := d.Get("user_id").(string)
+
+	log.Printf("[INFO] Creating PagerDuty user handoff notification rule for user %s", userID)
+
+	v := new(handoffNotificationRulePayload)
+	payload := &handoffNotificationRulePayload{Rule: buildUserHandoffNotificationRuleStruct(d)}
+	if _, err := apiRequest(client, "POST", handoffNotificationRulesPath(userID), nil, payload, v); err != nil {
+		return err
+	}
+
+	d.SetId(v.Rule.ID)
+
+	return fetchPagerDutyUserHandoffNotificationRule(d, meta, genError)
+}
+
+func resourcePagerDutyUserHandoffNotificationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return fetchPagerDutyUserHandoffNotificationRule(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyUserHandoffNotificationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating PagerDuty user handoff notification rule %s", d.Id())
+
+	userID := d.Get("user_id").(string)
+
+	payload := &handoffNotificationRulePayload{Rule: buildUserHandoffNotificationRuleStruct(d)}
+	if _, err := apiRequest(client, "PUT", handoffNotificationRulesPath(userID)+"/"+d.Id(), nil, payload, nil); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyUserHandoffNotificationRuleRead(d, meta)
+}
+
+func resourcePagerDutyUserHandoffNotificationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting PagerDuty user handoff notification rule %s", d.Id())
+
+	userID := d.Get("user_id").(string)
+
+	if _, err := apiRequest(client, "DELETE", handoffNotificationRulesPath(userID)+"/"+d.Id(), nil, nil, nil); err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyUserHandoffNotificationRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids, err := parseCompositeImportID("pagerduty_user_handoff_notification_rule", d.Id(), "user_id", "handoff_notification_rule_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	uid, id := ids[0], ids[1]
+
+	if _, err := apiRequest(client, "GET", handoffNotificationRulesPath(uid)+"/"+id, nil, nil, nil); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(id)
+	d.Set("user_id", uid)
+
+	return []*schema.ResourceData{d}, nil
+}
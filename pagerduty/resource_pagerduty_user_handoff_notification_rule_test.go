@@ -0,0 +1,110 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyUserHandoffNotificationRule_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserHandoffNotificationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserHandoffNotificationRuleConfig(username, email, "both", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserHandoffNotificationRuleExists("pagerduty_user_handoff_notification_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_handoff_notification_rule.foo", "handoff_type", "both"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_handoff_notification_rule.foo", "notify_advance_in_minutes", "60"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyUserHandoffNotificationRuleConfig(username, email, "oncall", 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserHandoffNotificationRuleExists("pagerduty_user_handoff_notification_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_handoff_notification_rule.foo", "handoff_type", "oncall"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_handoff_notification_rule.foo", "notify_advance_in_minutes", "180"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyUserHandoffNotificationRuleDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_user_handoff_notification_rule" {
+			continue
+		}
+
+		if _, err := apiRequest(client, "GET", handoffNotificationRulesPath(r.Primary.Attributes["user_id"])+"/"+r.Primary.ID, nil, nil, nil); err == nil {
+			return fmt.Errorf("User handoff notification rule still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyUserHandoffNotificationRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No user handoff notification rule ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		v := new(handoffNotificationRulePayload)
+		if _, err := apiRequest(client, "GET", handoffNotificationRulesPath(rs.Primary.Attributes["user_id"])+"/"+rs.Primary.ID, nil, nil, v); err != nil {
+			return err
+		}
+
+		if v.Rule.ID != rs.Primary.ID {
+			return fmt.Errorf("User handoff notification rule not found: %v - %v", rs.Primary.ID, v.Rule)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyUserHandoffNotificationRuleConfig(username, email, handoffType string, advance int) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[2]v"
+}
+
+resource "pagerduty_user_contact_method" "foo" {
+  user_id = pagerduty_user.foo.id
+  type    = "email_contact_method"
+  address = "%[2]v"
+  label   = "%[1]v"
+}
+
+resource "pagerduty_user_handoff_notification_rule" "foo" {
+  user_id                   = pagerduty_user.foo.id
+  handoff_type              = "%[3]v"
+  notify_advance_in_minutes = %[4]d
+
+  contact_method {
+    id   = pagerduty_user_contact_method.foo.id
+    type = "email_contact_method"
+  }
+}
+`, username, email, handoffType, advance)
+}
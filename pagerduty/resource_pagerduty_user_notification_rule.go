@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -34,12 +33,9 @@ func resourcePagerDutyUserNotificationRule() *schema.Resource {
 			},
 
 			"urgency": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: validateValueFunc([]string{
-					"high",
-					"low",
-				}),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateUrgency(),
 			},
 			"contact_method": {
 				Required: true,
@@ -173,10 +169,9 @@ func resourcePagerDutyUserNotificationRuleImport(d *schema.ResourceData, meta in
 		return []*schema.ResourceData{}, err
 	}
 
-	ids := strings.Split(d.Id(), ":")
-
-	if len(ids) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_user_notification_rule. Expecting an ID formed as '<user_id>.<notification_rule_id>'")
+	ids, err := parseCompositeImportID("pagerduty_user_notification_rule", d.Id(), "user_id", "notification_rule_id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 	uid, id := ids[0], ids[1]
 
@@ -0,0 +1,287 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyUserNotificationRules() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyUserNotificationRulesCreate,
+		Read:   resourcePagerDutyUserNotificationRulesRead,
+		Update: resourcePagerDutyUserNotificationRulesUpdate,
+		Delete: resourcePagerDutyUserNotificationRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyUserNotificationRulesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"urgency": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateUrgency(),
+						},
+						"start_delay_in_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"contact_method": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validateValueFunc([]string{
+											"email_contact_method",
+											"phone_contact_method",
+											"push_notification_contact_method",
+											"sms_contact_method",
+										}),
+									},
+								},
+							},
+						},
+					},
+				},
+				Set: resourcePagerDutyUserNotificationRulesHash,
+			},
+		},
+	}
+}
+
+// resourcePagerDutyUserNotificationRulesHash hashes a rule on its urgency,
+// delay and contact method only, so rules read back from the API with an ID
+// match the rules declared in the configuration.
+func resourcePagerDutyUserNotificationRulesHash(v interface{}) int {
+	return schema.HashString(notificationRuleKey(expandUserNotificationRulesRule(v)))
+}
+
+func notificationRuleKey(r *pagerduty.NotificationRule) string {
+	contactMethodID := ""
+	if r.ContactMethod != nil {
+		contactMethodID = r.ContactMethod.ID
+	}
+	return fmt.Sprintf("%s-%d-%s", r.Urgency, r.StartDelayInMinutes, contactMethodID)
+}
+
+func expandUserNotificationRulesRule(v interface{}) *pagerduty.NotificationRule {
+	r := v.(map[string]interface{})
+
+	rule := &pagerduty.NotificationRule{
+		Type:                "assignment_notification_rule",
+		Urgency:             r["urgency"].(string),
+		StartDelayInMinutes: r["start_delay_in_minutes"].(int),
+	}
+
+	if cms, ok := r["contact_method"].([]interface{}); ok && len(cms) > 0 && cms[0] != nil {
+		cm := cms[0].(map[string]interface{})
+		rule.ContactMethod = &pagerduty.ContactMethodReference{
+			ID:   cm["id"].(string),
+			Type: cm["type"].(string),
+		}
+	}
+
+	return rule
+}
+
+func flattenUserNotificationRules(rules []*pagerduty.NotificationRule) []interface{} {
+	var result []interface{}
+
+	for _, r := range rules {
+		if r.Type != "" && r.Type != "assignment_notification_rule" {
+			continue
+		}
+
+		rule := map[string]interface{}{
+			"id":                     r.ID,
+			"urgency":                r.Urgency,
+			"start_delay_in_minutes": r.StartDelayInMinutes,
+		}
+		if r.ContactMethod != nil {
+			rule["contact_method"] = []interface{}{
+				map[string]interface{}{
+					"id":   r.ContactMethod.ID,
+					"type": r.ContactMethod.Type,
+				},
+			}
+		}
+
+		result = append(result, rule)
+	}
+
+	return result
+}
+
+// reconcileUserNotificationRules makes the assignment notification rules of
+// a user match the desired rules. Missing rules are created before any extra
+// rule is deleted, so the user is never left without a way to be notified.
+func reconcileUserNotificationRules(client *pagerduty.Client, userID string, desired []interface{}) error {
+	resp, _, err := client.Users.ListNotificationRules(userID)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]*pagerduty.NotificationRule)
+	for _, r := range resp.NotificationRules {
+		if r.Type != "" && r.Type != "assignment_notification_rule" {
+			continue
+		}
+		existing[notificationRuleKey(r)] = r
+	}
+
+	wanted := make(map[string]bool)
+	for _, v := range desired {
+		rule := expandUserNotificationRulesRule(v)
+		key := notificationRuleKey(rule)
+		wanted[key] = true
+
+		if _, ok := existing[key]; ok {
+			continue
+		}
+
+		log.Printf("[INFO] Creating PagerDuty %s urgency notification rule for user %s", rule.Urgency, userID)
+
+		if _, _, err := client.Users.CreateNotificationRule(userID, rule); err != nil {
+			return err
+		}
+	}
+
+	for key, r := range existing {
+		if wanted[key] {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting PagerDuty notification rule %s of user %s", r.ID, userID)
+
+		if _, err := client.Users.DeleteNotificationRule(userID, r.ID); err != nil {
+			if isErrCode(err, 404) {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourcePagerDutyUserNotificationRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	userID := d.Get("user_id").(string)
+
+	if err := reconcileUserNotificationRules(client, userID, d.Get("rule").(*schema.Set).List()); err != nil {
+		return err
+	}
+
+	d.SetId(userID)
+
+	return resourcePagerDutyUserNotificationRulesRead(d, meta)
+}
+
+func resourcePagerDutyUserNotificationRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty notification rules of user %s", d.Id())
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.Users.ListNotificationRules(d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("user_id", d.Id())
+		if err := d.Set("rule", flattenUserNotificationRules(resp.NotificationRules)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyUserNotificationRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating PagerDuty notification rules of user %s", d.Id())
+
+	if err := reconcileUserNotificationRules(client, d.Id(), d.Get("rule").(*schema.Set).List()); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyUserNotificationRulesRead(d, meta)
+}
+
+func resourcePagerDutyUserNotificationRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting PagerDuty notification rules of user %s", d.Id())
+
+	for _, v := range d.Get("rule").(*schema.Set).List() {
+		id := v.(map[string]interface{})["id"].(string)
+		if id == "" {
+			continue
+		}
+
+		if _, err := client.Users.DeleteNotificationRule(d.Id(), id); err != nil {
+			if isErrCode(err, 404) {
+				continue
+			}
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyUserNotificationRulesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("user_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
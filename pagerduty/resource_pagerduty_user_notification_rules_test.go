@@ -0,0 +1,144 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyUserNotificationRules_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserNotificationRulesConfig(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserNotificationRulesCount("pagerduty_user_notification_rules.foo", 2),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_notification_rules.foo", "rule.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyUserNotificationRulesConfigUpdated(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserNotificationRulesCount("pagerduty_user_notification_rules.foo", 1),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_notification_rules.foo", "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyUserNotificationRulesCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No user ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		resp, _, err := client.Users.ListNotificationRules(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		count := 0
+		for _, r := range resp.NotificationRules {
+			if r.Type == "assignment_notification_rule" {
+				count++
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("Expected %d notification rules for user %s, found %d", expected, rs.Primary.ID, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyUserNotificationRulesConfig(username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[2]v"
+}
+
+resource "pagerduty_user_contact_method" "phone" {
+  user_id      = pagerduty_user.foo.id
+  type         = "phone_contact_method"
+  country_code = "+1"
+  address      = "2025550199"
+  label        = "%[1]v"
+}
+
+resource "pagerduty_user_notification_rules" "foo" {
+  user_id = pagerduty_user.foo.id
+
+  rule {
+    urgency                = "high"
+    start_delay_in_minutes = 0
+
+    contact_method {
+      type = "phone_contact_method"
+      id   = pagerduty_user_contact_method.phone.id
+    }
+  }
+
+  rule {
+    urgency                = "high"
+    start_delay_in_minutes = 5
+
+    contact_method {
+      type = "phone_contact_method"
+      id   = pagerduty_user_contact_method.phone.id
+    }
+  }
+}
+`, username, email)
+}
+
+func testAccCheckPagerDutyUserNotificationRulesConfigUpdated(username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[2]v"
+}
+
+resource "pagerduty_user_contact_method" "phone" {
+  user_id      = pagerduty_user.foo.id
+  type         = "phone_contact_method"
+  country_code = "+1"
+  address      = "2025550199"
+  label        = "%[1]v"
+}
+
+resource "pagerduty_user_notification_rules" "foo" {
+  user_id = pagerduty_user.foo.id
+
+  rule {
+    urgency                = "high"
+    start_delay_in_minutes = 0
+
+    contact_method {
+      type = "phone_contact_method"
+      id   = pagerduty_user_contact_method.phone.id
+    }
+  }
+}
+`, username, email)
+}
@@ -3,6 +3,8 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"os"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -151,6 +153,141 @@ func TestAccPagerDutyUserWithTeams_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyUser_License(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	license := os.Getenv("PAGERDUTY_ACC_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if license == "" {
+				t.Skip("PAGERDUTY_ACC_LICENSE_ID not set. Skipping test")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserWithLicenseConfig(username, email, license),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserExists("pagerduty_user.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user.foo", "license", license),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyUser_InvalidRoleAndColor(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyUserWithRoleAndColorConfig(username, email, "owner", "green"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the owner role can't be assigned through the PagerDuty API"),
+			},
+			{
+				Config:      testAccCheckPagerDutyUserWithRoleAndColorConfig(username, email, "user", "dark_green"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Did you mean "dark-green"\?`),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyUser_OnDestroyReassign(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	fallback := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserOnDestroyReassignConfig(username, fallback, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserExists("pagerduty_user.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user.foo", "on_destroy", "reassign"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyUserOnDestroyReassignConfigRemoved(fallback, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationPolicyTargetsUser("pagerduty_escalation_policy.foo", "pagerduty_user.fallback"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyUser_ExternallyManaged(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserExternallyManagedConfig(username, email, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserExists("pagerduty_user.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user.foo", "name", username),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyUserExternallyManagedConfig(username+"-scim", "scim."+email, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserExists("pagerduty_user.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user.foo", "name", username),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user.foo", "email", email),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user.foo", "job_title", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEscalationPolicyTargetsUser(ep, user string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		policyID := s.RootModule().Resources[ep].Primary.ID
+		userID := s.RootModule().Resources[user].Primary.ID
+
+		policy, _, err := client.EscalationPolicies.Get(policyID, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range policy.EscalationRules {
+			for _, target := range rule.Targets {
+				if target.ID == userID {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Escalation policy %s does not target user %s", policyID, userID)
+	}
+}
+
 func testAccCheckPagerDutyUserDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -278,3 +415,93 @@ resource "pagerduty_user" "foo" {
 }
 `, team1, team2, username, email)
 }
+
+func testAccCheckPagerDutyUserWithLicenseConfig(username, email, license string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name    = "%s"
+  email   = "%s"
+  role    = "user"
+  license = "%s"
+}`, username, email, license)
+}
+
+func testAccCheckPagerDutyUserWithRoleAndColorConfig(username, email, role, color string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+  role  = "%s"
+  color = "%s"
+}`, username, email, role, color)
+}
+
+func testAccCheckPagerDutyUserOnDestroyReassignConfig(username, fallback, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name             = "%[1]v"
+  email            = "%[1]v@foo.test"
+  on_destroy       = "reassign"
+  fallback_user_id = pagerduty_user.fallback.id
+}
+
+resource "pagerduty_user" "fallback" {
+  name  = "%[2]v"
+  email = "%[2]v@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name = "%[3]v"
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [rule]
+  }
+}
+`, username, fallback, escalationPolicy)
+}
+
+func testAccCheckPagerDutyUserOnDestroyReassignConfigRemoved(fallback, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "fallback" {
+  name  = "%[1]v"
+  email = "%[1]v@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name = "%[2]v"
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.fallback.id
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [rule]
+  }
+}
+`, fallback, escalationPolicy)
+}
+
+func testAccCheckPagerDutyUserExternallyManagedConfig(username, email, jobTitle string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name               = "%s"
+  email              = "%s"
+  job_title          = "%s"
+  externally_managed = true
+}
+`, username, email, jobTitle)
+}
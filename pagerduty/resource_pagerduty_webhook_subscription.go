@@ -1,9 +1,15 @@
 package pagerduty
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -15,6 +21,11 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 		Read:   resourcePagerDutyWebhookSubscriptionRead,
 		Update: resourcePagerDutyWebhookSubscriptionUpdate,
 		Delete: resourcePagerDutyWebhookSubscriptionDelete,
+		CustomizeDiff: customdiff.All(
+			validateWebhookSubscriptionFilter,
+			validateWebhookSubscriptionEvents,
+			planWebhookSubscriptionReenabling,
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -38,8 +49,9 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 							}),
 						},
 						"url": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateWebhookURL,
 						},
 						"custom_header": {
 							Type:     schema.TypeList,
@@ -52,9 +64,10 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 									},
 
 									"value": {
-										Type:     schema.TypeString,
-										Required: true,
-										ForceNew: true,
+										Type:      schema.TypeString,
+										Required:  true,
+										ForceNew:  true,
+										Sensitive: true,
 										// Suppress the diff shown if the base_image name are equal when both compared in lower case.
 										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 											if old == "-- redacted --" {
@@ -80,7 +93,16 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 			"active": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				Computed: true,
+				Default:  true,
+			},
+			"destroy_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "delete",
+				ValidateFunc: validateValueFunc([]string{
+					"delete",
+					"disable",
+				}),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -90,9 +112,19 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateWebhookEventType,
 				},
 			},
+			"send_test_event": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"test_event_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"filter": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -118,6 +150,138 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 	}
 }
 
+// webhookEventTypes is the catalog of event types supported by v3 webhook
+// subscriptions. See https://developer.pagerduty.com/docs/db0fa8c8984fc-overview#event-types
+var webhookEventTypes = []string{
+	"incident.acknowledged",
+	"incident.annotated",
+	"incident.conference_bridge.updated",
+	"incident.custom_field_values.updated",
+	"incident.delegated",
+	"incident.escalated",
+	"incident.incident_type.changed",
+	"incident.priority_updated",
+	"incident.reassigned",
+	"incident.reopened",
+	"incident.resolved",
+	"incident.responder.added",
+	"incident.responder.replied",
+	"incident.status_update_published",
+	"incident.triggered",
+	"incident.unacknowledged",
+	"incident.workflow.completed",
+	"incident.workflow.started",
+	"pagey.ping",
+	"service.created",
+	"service.deleted",
+	"service.updated",
+}
+
+var webhookEventTypeRegexp = regexp.MustCompile(`^[a-z_]+(\.[a-z_]+)+$`)
+
+// validateWebhookEventType rejects malformed event types, and only warns
+// about event types missing from the catalog so that event types introduced
+// by PagerDuty can be used before the catalog is updated.
+func validateWebhookEventType(v interface{}, k string) (warns []string, errs []error) {
+	value := v.(string)
+
+	if !webhookEventTypeRegexp.MatchString(value) {
+		errs = append(errs, fmt.Errorf("%s: %q is not a valid event type, expecting a value such as `incident.triggered`", k, value))
+		return
+	}
+
+	for _, t := range webhookEventTypes {
+		if t == value {
+			return
+		}
+	}
+
+	warns = append(warns, fmt.Sprintf("%s: %q is not a known event type, valid event types include: %s", k, value, strings.Join(webhookEventTypes, ", ")))
+	return
+}
+
+// validateWebhookSubscriptionFilter makes sure the filter of a webhook
+// subscription references an object only when its type requires one.
+func validateWebhookSubscriptionFilter(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for i := 0; i < diff.Get("filter.#").(int); i++ {
+		prefix := fmt.Sprintf("filter.%d", i)
+
+		if !diff.NewValueKnown(prefix+".id") || !diff.NewValueKnown(prefix+".type") {
+			continue
+		}
+
+		filterType := diff.Get(prefix + ".type").(string)
+		id := diff.Get(prefix + ".id").(string)
+
+		switch {
+		case filterType == "account_reference" && id != "":
+			return fmt.Errorf("%s: `id` must not be set when `type` is `account_reference`", prefix)
+		case filterType != "account_reference" && id == "":
+			return fmt.Errorf("%s: `id` is required when `type` is `%s`", prefix, filterType)
+		}
+	}
+
+	return nil
+}
+
+// validateWebhookURL requires the absolute HTTPS URLs PagerDuty delivers
+// webhooks to. Fragments are rejected, as they are never sent to the server.
+func validateWebhookURL(v interface{}, k string) (warns []string, errs []error) {
+	value := v.(string)
+
+	u, err := url.Parse(value)
+	switch {
+	case err != nil:
+		errs = append(errs, fmt.Errorf("%s: %q is not a valid URL: %s", k, value, err))
+	case u.Scheme != "https" || u.Host == "":
+		errs = append(errs, fmt.Errorf("%s: %q must be an absolute URL using https, such as https://example.com/webhooks", k, value))
+	case u.Fragment != "" || strings.Contains(value, "#"):
+		errs = append(errs, fmt.Errorf("%s: %q must not have a fragment", k, value))
+	}
+	return
+}
+
+// validateWebhookSubscriptionEvents rejects event types listed more than
+// once.
+func validateWebhookSubscriptionEvents(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	seen := make(map[string]int)
+	for i := 0; i < diff.Get("events.#").(int); i++ {
+		key := fmt.Sprintf("events.%d", i)
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		event := diff.Get(key).(string)
+		if j, ok := seen[event]; ok {
+			return fmt.Errorf("%s: %q is already listed in events.%d", key, event, j)
+		}
+		seen[event] = i
+	}
+
+	return nil
+}
+
+// planWebhookSubscriptionReenabling warns about subscriptions PagerDuty
+// temporarily disabled after repeated delivery failures that the apply enables
+// again, which only happens when temporarily_disabled is explicitly set to
+// false. It can't be set to true, as only PagerDuty disables subscriptions.
+func planWebhookSubscriptionReenabling(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	key := "delivery_method.0.temporarily_disabled"
+	if !diff.HasChange(key) || !diff.NewValueKnown(key) {
+		return nil
+	}
+
+	o, n := diff.GetChange(key)
+	if n.(bool) {
+		return fmt.Errorf("%s: can't be set to true, as PagerDuty temporarily disables webhook subscriptions on its own when their deliveries keep failing", key)
+	}
+	if o.(bool) {
+		log.Printf("[WARN] PagerDuty webhook subscription %s was temporarily disabled after repeated delivery failures to %s, it will be enabled again", diff.Id(), diff.Get("delivery_method.0.url"))
+	}
+
+	return nil
+}
+
 func buildWebhookSubscriptionStruct(d *schema.ResourceData) *pagerduty.WebhookSubscription {
 	webhook := pagerduty.WebhookSubscription{
 		Type:           d.Get("type").(string),
@@ -157,34 +321,88 @@ func resourcePagerDutyWebhookSubscriptionCreate(d *schema.ResourceData, meta int
 		return retryErr
 	}
 
-	return resourcePagerDutyWebhookSubscriptionRead(d, meta)
+	if d.Get("send_test_event").(bool) {
+		if err := sendWebhookSubscriptionTestEvent(d, client); err != nil {
+			return err
+		}
+	}
+
+	// active is left out of the create request when false, as it's omitted
+	// when empty, so the subscription is deactivated once created.
+	if !webhook.Active {
+		if err := deactivateWebhookSubscription(client, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return fetchPagerDutyWebhookSubscription(d, meta, genError)
 
 }
 
-func resourcePagerDutyWebhookSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+// sendWebhookSubscriptionTestEvent asks PagerDuty to deliver a test event to
+// the subscription, so a broken receiver is caught at apply time. PagerDuty
+// only acknowledges the request, the delivery itself is asynchronous.
+func sendWebhookSubscriptionTestEvent(d *schema.ResourceData, client *pagerduty.Client) error {
+	log.Printf("[INFO] Sending test event to PagerDuty webhook subscription %s", d.Id())
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, err := apiRequest(client, "POST", fmt.Sprintf("/webhook_subscriptions/%s/ping", d.Id()), nil, nil, nil); err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		d.Set("test_event_status", "failed")
+		return fmt.Errorf("Error sending test event to webhook subscription %s: %s", d.Id(), retryErr)
+	}
+
+	d.Set("test_event_status", "accepted")
+
+	return nil
+}
+
+func fetchPagerDutyWebhookSubscription(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Reading PagerDuty webhook subscription %s", d.Id())
-
 	return resource.Retry(30*time.Second, func() *resource.RetryError {
 		if webhook, _, err := client.WebhookSubscriptions.Get(d.Id()); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if webhook != nil {
 			setWebhookResourceData(d, webhook)
 		}
 		return nil
 	})
 }
+
+func resourcePagerDutyWebhookSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty webhook subscription %s", d.Id())
+	return fetchPagerDutyWebhookSubscription(d, meta, handleNotFoundError)
+}
 func resourcePagerDutyWebhookSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
 	}
 
+	if err := clearDescription(client, d, "/webhook_subscriptions/"+d.Id(), "webhook_subscription"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Updating PagerDuty webhook subscription %s", d.Id())
 	whStruct := buildWebhookSubscriptionStruct(d)
 
@@ -195,6 +413,20 @@ func resourcePagerDutyWebhookSubscriptionUpdate(d *schema.ResourceData, meta int
 		setWebhookResourceData(d, webhook)
 	}
 
+	if o, _ := d.GetChange("delivery_method.0.temporarily_disabled"); o.(bool) && !whStruct.DeliveryMethod.TemporarilyDisabled {
+		if err := enableWebhookSubscription(client, d.Id()); err != nil {
+			return err
+		}
+		d.Set("delivery_method", flattenDeliveryMethod(whStruct.DeliveryMethod))
+	}
+
+	if d.HasChange("active") && !whStruct.Active {
+		if err := deactivateWebhookSubscription(client, d.Id()); err != nil {
+			return err
+		}
+		d.Set("active", false)
+	}
+
 	return nil
 }
 
@@ -204,6 +436,17 @@ func resourcePagerDutyWebhookSubscriptionDelete(d *schema.ResourceData, meta int
 		return err
 	}
 
+	if d.Get("destroy_behavior").(string) == "disable" {
+		log.Printf("[INFO] Deactivating PagerDuty webhook subscription %s instead of deleting it", d.Id())
+
+		if err := deactivateWebhookSubscription(client, d.Id()); err != nil {
+			return err
+		}
+
+		d.SetId("")
+		return nil
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty webhook subscription %s", d.Id())
 
 	if _, err := client.WebhookSubscriptions.Delete(d.Id()); err != nil {
@@ -215,6 +458,46 @@ func resourcePagerDutyWebhookSubscriptionDelete(d *schema.ResourceData, meta int
 	return nil
 }
 
+// deactivateWebhookSubscription sends active explicitly, as the client omits
+// it from the payload when it's false.
+func deactivateWebhookSubscription(client *pagerduty.Client, id string) error {
+	body := map[string]interface{}{
+		"webhook_subscription": map[string]interface{}{
+			"active": false,
+		},
+	}
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, err := apiRequest(client, "PUT", "/webhook_subscriptions/"+id, nil, body, nil); err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// enableWebhookSubscription enables a subscription temporarily disabled by
+// PagerDuty, which can't be done by updating it.
+func enableWebhookSubscription(client *pagerduty.Client, id string) error {
+	log.Printf("[INFO] Enabling temporarily disabled PagerDuty webhook subscription %s", id)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, err := apiRequest(client, "POST", fmt.Sprintf("/webhook_subscriptions/%s/enable", id), nil, nil, nil); err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
 func setWebhookResourceData(d *schema.ResourceData, webhook *pagerduty.WebhookSubscription) {
 	d.Set("type", webhook.Type)
 	d.Set("active", webhook.Active)
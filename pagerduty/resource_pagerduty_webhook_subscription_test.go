@@ -2,7 +2,10 @@ package pagerduty
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -71,6 +74,164 @@ func TestAccPagerDutyWebhookSubscription_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyWebhookSubscription_SendTestEvent(t *testing.T) {
+	description := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyWebhookSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionSendTestEventConfig(description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyWebhookSubscriptionExists("pagerduty_webhook_subscription.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "send_test_event", "true"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "test_event_status", "accepted"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyWebhookSubscription_InvalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyWebhookSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionFilterConfig("account_reference", `id = "PXXXXXX"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`id` must not be set when `type` is `account_reference`"),
+			},
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionFilterConfig("team_reference", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`id` is required when `type` is `team_reference`"),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyWebhookSubscription_InvalidEvent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyWebhookSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionEventConfig("Incident Triggered"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not a valid event type"),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyWebhookSubscription_InvalidDelivery(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyWebhookSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionDeliveryConfig(`url = "http://example.com/receive_a_pagerduty_webhook"`, `"incident.triggered"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be an absolute URL using https"),
+			},
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionDeliveryConfig(`url = "https://example.com/receive_a_pagerduty_webhook#pd"`, `"incident.triggered"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must not have a fragment"),
+			},
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionDeliveryConfig(`url = "https://example.com/receive_a_pagerduty_webhook"`, `"incident.triggered", "incident.resolved", "incident.triggered"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`events.2: "incident.triggered" is already listed in events.0`),
+			},
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionDeliveryConfig(`url = "https://example.com/receive_a_pagerduty_webhook"
+    temporarily_disabled = true`, `"incident.triggered"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("temporarily_disabled: can't be set to true"),
+			},
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionDeliveryConfig(`url = "https://example.com/receive_a_pagerduty_webhook"
+    temporarily_disabled = false`, `"incident.triggered"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestResourcePagerDutyWebhookSubscriptionCreate_Inactive(t *testing.T) {
+	var deactivated bool
+	config := testStubbedConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/webhook_subscriptions":
+			w.Write([]byte(`{"webhook_subscription":{"id":"PWEBHOOK","active":true}}`))
+		case r.Method == "PUT" && r.URL.Path == "/webhook_subscriptions/PWEBHOOK":
+			body, _ := ioutil.ReadAll(r.Body)
+			deactivated = strings.Contains(string(body), `"active":false`)
+			w.Write([]byte(`{"webhook_subscription":{"id":"PWEBHOOK","active":false}}`))
+		case r.Method == "GET" && r.URL.Path == "/webhook_subscriptions/PWEBHOOK":
+			active := fmt.Sprint(!deactivated)
+			w.Write([]byte(`{"webhook_subscription":{"id":"PWEBHOOK","active":` + active + `}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	d := resourcePagerDutyWebhookSubscription().TestResourceData()
+	d.Set("active", false)
+	d.Set("events", []interface{}{"incident.triggered"})
+	d.Set("delivery_method", []interface{}{map[string]interface{}{
+		"type": "http_delivery_method",
+		"url":  "https://example.com/receive_a_pagerduty_webhook",
+	}})
+	d.Set("filter", []interface{}{map[string]interface{}{
+		"type": "account_reference",
+	}})
+
+	if err := resourcePagerDutyWebhookSubscriptionCreate(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if !deactivated {
+		t.Errorf("expected the subscription to be deactivated after being created")
+	}
+	if d.Get("active").(bool) {
+		t.Errorf("expected the subscription to be inactive in the state")
+	}
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{"https://example.com/receive_a_pagerduty_webhook", true},
+		{"https://example.com:8443/hooks?token=abc", true},
+		{"http://example.com/hooks", false},
+		{"https:///hooks", false},
+		{"example.com/hooks", false},
+		{"https://example.com/hooks#", false},
+		{"https://example.com/hooks#pd", false},
+		{"", false},
+	}
+
+	for _, c := range cases {
+		_, errs := validateWebhookURL(c.value, "url")
+		if valid := len(errs) == 0; valid != c.valid {
+			t.Errorf("expected %q to be valid: %t, got errors: %v", c.value, c.valid, errs)
+		}
+	}
+}
+
 func testAccCheckPagerDutyWebhookSubscriptionDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -175,3 +336,66 @@ func testAccCheckPagerDutyWebhookSubscriptionConfig(username, useremail, escalat
 	}
 	`, username, useremail, escalationPolicy, service, description)
 }
+
+func testAccCheckPagerDutyWebhookSubscriptionFilterConfig(filterType, id string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_webhook_subscription" "foo" {
+  delivery_method {
+    type = "http_delivery_method"
+    url  = "https://example.com/receive_a_pagerduty_webhook"
+  }
+  events = ["incident.triggered"]
+  filter {
+    type = "%s"
+    %s
+  }
+}
+`, filterType, id)
+}
+
+func testAccCheckPagerDutyWebhookSubscriptionEventConfig(event string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_webhook_subscription" "foo" {
+  delivery_method {
+    type = "http_delivery_method"
+    url  = "https://example.com/receive_a_pagerduty_webhook"
+  }
+  events = ["%s"]
+  filter {
+    type = "account_reference"
+  }
+}
+`, event)
+}
+
+func testAccCheckPagerDutyWebhookSubscriptionDeliveryConfig(deliveryMethod, events string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_webhook_subscription" "foo" {
+  delivery_method {
+    type = "http_delivery_method"
+    %s
+  }
+  events = [%s]
+  filter {
+    type = "account_reference"
+  }
+}
+`, deliveryMethod, events)
+}
+
+func testAccCheckPagerDutyWebhookSubscriptionSendTestEventConfig(description string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_webhook_subscription" "foo" {
+  delivery_method {
+    type = "http_delivery_method"
+    url  = "https://example.com/receive_a_pagerduty_webhook"
+  }
+  description     = "%s"
+  events          = ["incident.triggered"]
+  send_test_event = true
+  filter {
+    type = "account_reference"
+  }
+}
+`, description)
+}
@@ -0,0 +1,208 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// serviceDependencyNode is a service or business service in the graph of
+// dependencies.
+type serviceDependencyNode struct {
+	Type string
+	ID   string
+}
+
+func (n serviceDependencyNode) String() string {
+	return fmt.Sprintf("%s %s", n.Type, n.ID)
+}
+
+// newServiceDependencyNode names the reference types accepted for dependent
+// services after the types they refer to.
+func newServiceDependencyNode(serviceType, id string) serviceDependencyNode {
+	switch serviceType {
+	case "business_service_reference":
+		serviceType = "business_service"
+	case "technical_service_reference":
+		serviceType = "service"
+	}
+	return serviceDependencyNode{Type: serviceType, ID: id}
+}
+
+// serviceDependencyGraph collects the dependencies planned during a run, so
+// that a dependency closing a cycle with the others is reported at plan with
+// the path of the cycle. The API rejects such a dependency with an unhelpful
+// error, once the other dependencies have already been created.
+type serviceDependencyGraph struct {
+	mu      sync.Mutex
+	planned map[serviceDependencyNode]map[serviceDependencyNode]bool
+	live    map[serviceDependencyNode][]serviceDependencyNode
+}
+
+// add records that dependent depends on supporting, and returns the cycle
+// this dependency closes, starting and ending with dependent, if any. The
+// dependencies existing in PagerDuty are followed too when a client is given.
+func (g *serviceDependencyGraph) add(client *pagerduty.Client, dependent, supporting serviceDependencyNode) ([]serviceDependencyNode, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.planned == nil {
+		g.planned = make(map[serviceDependencyNode]map[serviceDependencyNode]bool)
+	}
+	if g.planned[dependent] == nil {
+		g.planned[dependent] = make(map[serviceDependencyNode]bool)
+	}
+	g.planned[dependent][supporting] = true
+
+	path, err := g.path(client, supporting, dependent, map[serviceDependencyNode]bool{})
+	if err != nil || path == nil {
+		return nil, err
+	}
+	return append([]serviceDependencyNode{dependent}, path...), nil
+}
+
+// path returns the dependencies leading from one node to another, both
+// included, with a depth first search.
+func (g *serviceDependencyGraph) path(client *pagerduty.Client, from, to serviceDependencyNode, visited map[serviceDependencyNode]bool) ([]serviceDependencyNode, error) {
+	if from == to {
+		return []serviceDependencyNode{to}, nil
+	}
+	if visited[from] {
+		return nil, nil
+	}
+	visited[from] = true
+
+	supporting, err := g.supporting(client, from)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, next := range supporting {
+		path, err := g.path(client, next, to, visited)
+		if err != nil {
+			return nil, err
+		}
+		if path != nil {
+			return append([]serviceDependencyNode{from}, path...), nil
+		}
+	}
+	return nil, nil
+}
+
+// supporting returns the services the given one depends on, as planned and,
+// with a client, as they exist in PagerDuty.
+func (g *serviceDependencyGraph) supporting(client *pagerduty.Client, node serviceDependencyNode) ([]serviceDependencyNode, error) {
+	var nodes []serviceDependencyNode
+	for n := range g.planned[node] {
+		nodes = append(nodes, n)
+	}
+	// The cycle reported is always the same one
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].String() < nodes[j].String() })
+
+	if client == nil {
+		return nodes, nil
+	}
+
+	live, ok := g.live[node]
+	if !ok {
+		var err error
+		if live, err = listSupportingServices(client, node); err != nil {
+			return nil, err
+		}
+
+		if g.live == nil {
+			g.live = make(map[serviceDependencyNode][]serviceDependencyNode)
+		}
+		g.live[node] = live
+	}
+
+	return append(nodes, live...), nil
+}
+
+func listSupportingServices(client *pagerduty.Client, node serviceDependencyNode) ([]serviceDependencyNode, error) {
+	var nodes []serviceDependencyNode
+
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		dependencies, _, err := client.ServiceDependencies.GetServiceDependenciesForType(node.ID, node.Type)
+		if err != nil {
+			// Services created by the same apply don't exist yet
+			if isErrCode(err, 404) {
+				return nil
+			}
+			if isErrCode(err, 429) || isErrCode(err, 500) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		nodes = nil
+		for _, rel := range dependencies.Relationships {
+			if rel.DependentService == nil || rel.SupportingService == nil || rel.DependentService.ID != node.ID {
+				continue
+			}
+			nodes = append(nodes, newServiceDependencyNode(rel.SupportingService.Type, rel.SupportingService.ID))
+		}
+		return nil
+	})
+
+	return nodes, err
+}
+
+// checkServiceDependencyCycles fails the plan of a dependency which closes a
+// cycle with the other dependencies of the configuration, or with the
+// existing ones when check_dependency_cycles is enabled on the provider.
+func checkServiceDependencyCycles(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config, ok := meta.(*Config)
+	if !ok {
+		return nil
+	}
+
+	keys := []string{
+		"dependency.0.dependent_service.0.id",
+		"dependency.0.dependent_service.0.type",
+		"dependency.0.supporting_service.0.id",
+		"dependency.0.supporting_service.0.type",
+	}
+	for _, key := range keys {
+		// Services created by the same apply can't be told apart yet
+		if !diff.NewValueKnown(key) || diff.Get(key).(string) == "" {
+			return nil
+		}
+	}
+
+	dependent := newServiceDependencyNode(diff.Get(keys[1]).(string), diff.Get(keys[0]).(string))
+	supporting := newServiceDependencyNode(diff.Get(keys[3]).(string), diff.Get(keys[2]).(string))
+
+	var client *pagerduty.Client
+	if config.CheckDependencyCycles {
+		var err error
+		if client, err = config.Client(); err != nil {
+			return err
+		}
+	}
+
+	cycle, err := config.dependencies.add(client, dependent, supporting)
+	if err != nil {
+		return fmt.Errorf("Error checking the dependencies of %s for cycles: %s", dependent, err)
+	}
+	if cycle != nil {
+		return fmt.Errorf("the dependency of %s on %s creates a cycle: %s", dependent, supporting, formatServiceDependencyCycle(cycle))
+	}
+
+	return nil
+}
+
+func formatServiceDependencyCycle(cycle []serviceDependencyNode) string {
+	names := make([]string, len(cycle))
+	for i, n := range cycle {
+		names[i] = n.String()
+	}
+	return strings.Join(names, " -> ")
+}
@@ -0,0 +1,38 @@
+package pagerduty
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestServiceDependencyGraph(t *testing.T) {
+	a := newServiceDependencyNode("service", "PA")
+	b := newServiceDependencyNode("technical_service_reference", "PB")
+	c := newServiceDependencyNode("business_service", "PC")
+
+	var g serviceDependencyGraph
+	for _, dep := range [][2]serviceDependencyNode{{a, b}, {b, c}} {
+		if cycle, err := g.add(nil, dep[0], dep[1]); err != nil || cycle != nil {
+			t.Fatalf("expected no cycle adding %s -> %s, got %v, %v", dep[0], dep[1], cycle, err)
+		}
+	}
+
+	cycle, err := g.add(nil, newServiceDependencyNode("business_service_reference", "PC"), a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []serviceDependencyNode{c, a, b, c}; !reflect.DeepEqual(cycle, expected) {
+		t.Errorf("expected the cycle %v, got %v", expected, cycle)
+	}
+	if got, expected := formatServiceDependencyCycle(cycle), "business_service PC -> service PA -> service PB -> business_service PC"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if cycle, _ := g.add(nil, a, c); !reflect.DeepEqual(cycle, []serviceDependencyNode{a, c, a}) {
+		t.Errorf("expected the cycle %v, got %v", []serviceDependencyNode{a, c, a}, cycle)
+	}
+
+	if cycle, _ := g.add(nil, b, b); !reflect.DeepEqual(cycle, []serviceDependencyNode{b, b}) {
+		t.Errorf("expected a service depending on itself to be a cycle, got %v", cycle)
+	}
+}
@@ -0,0 +1,99 @@
+package pagerduty
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// tagAssignmentBatchWindow is how long tag assignment changes to an entity
+// are collected before they are sent together. Terraform creates and deletes
+// the assignments of a configuration in parallel, so changes made in the same
+// apply all arrive within it.
+var tagAssignmentBatchWindow = time.Second
+
+// tagAssignmentBatches groups the tags added to and removed from each entity
+// during an apply, so that they are changed with a single call to the
+// change_tags endpoint of the entity instead of one call per tag.
+type tagAssignmentBatches struct {
+	mu      sync.Mutex
+	pending map[string]*tagAssignmentBatch
+}
+
+type tagAssignmentBatch struct {
+	assignments pagerduty.TagAssignments
+	done        chan struct{}
+	err         error
+}
+
+// add assigns the tag to its entity along with the other tags of the batch,
+// and returns once the batch has been sent.
+func (b *tagAssignmentBatches) add(client *pagerduty.Client, assignment *pagerduty.TagAssignment) error {
+	return b.change(client, assignment, false)
+}
+
+// remove unassigns the tag from its entity along with the other tags of the
+// batch, and returns once the batch has been sent.
+func (b *tagAssignmentBatches) remove(client *pagerduty.Client, assignment *pagerduty.TagAssignment) error {
+	return b.change(client, assignment, true)
+}
+
+func (b *tagAssignmentBatches) change(client *pagerduty.Client, assignment *pagerduty.TagAssignment, remove bool) error {
+	key := assignment.EntityType + "/" + assignment.EntityID
+
+	b.mu.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &tagAssignmentBatch{done: make(chan struct{})}
+		if b.pending == nil {
+			b.pending = make(map[string]*tagAssignmentBatch)
+		}
+		b.pending[key] = batch
+		go b.send(client, key, assignment.EntityType, assignment.EntityID, batch)
+	}
+	if remove {
+		batch.assignments.Remove = append(batch.assignments.Remove, assignment)
+	} else {
+		batch.assignments.Add = append(batch.assignments.Add, assignment)
+	}
+	b.mu.Unlock()
+
+	<-batch.done
+	return batch.err
+}
+
+// send waits for the batch window to pass and sends the changes collected for
+// the entity. A failure is reported to every assignment of the batch.
+func (b *tagAssignmentBatches) send(client *pagerduty.Client, key, entityType, entityID string, batch *tagAssignmentBatch) {
+	defer close(batch.done)
+
+	time.Sleep(tagAssignmentBatchWindow)
+
+	// Changes arriving from now on start a new batch
+	b.mu.Lock()
+	delete(b.pending, key)
+	b.mu.Unlock()
+
+	log.Printf("[INFO] Changing tags of %s entity with ID %s: %d added, %d removed", entityType, entityID, len(batch.assignments.Add), len(batch.assignments.Remove))
+
+	// Newly created tags can take a moment to be assignable, so only removals
+	// are given up on quickly.
+	timeout := 10 * time.Second
+	if len(batch.assignments.Add) > 0 {
+		timeout = 5 * time.Minute
+	}
+
+	batch.err = resource.Retry(timeout, func() *resource.RetryError {
+		if _, err := client.Tags.Assign(entityType, entityID, &batch.assignments); err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,108 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func testTagAssignmentBatches(t *testing.T, status int) (*tagAssignmentBatches, *pagerduty.Client, *[]pagerduty.TagAssignments) {
+	window := tagAssignmentBatchWindow
+	tagAssignmentBatchWindow = 200 * time.Millisecond
+	t.Cleanup(func() { tagAssignmentBatchWindow = window })
+
+	var mu sync.Mutex
+	var requests []pagerduty.TagAssignments
+	config := testStubbedConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/teams/PTEAM/change_tags" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var assignments pagerduty.TagAssignments
+		if err := json.NewDecoder(r.Body).Decode(&assignments); err != nil {
+			t.Error(err)
+		}
+		mu.Lock()
+		requests = append(requests, assignments)
+		mu.Unlock()
+
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			w.Write([]byte(`{"error":{"message":"Forbidden","code":2010}}`))
+		}
+	})
+
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &tagAssignmentBatches{}, client, &requests
+}
+
+// changeTagsConcurrently adds and removes tags of the same team in parallel,
+// as Terraform does, and returns the error each of them got.
+func changeTagsConcurrently(b *tagAssignmentBatches, client *pagerduty.Client, added, removed int) []error {
+	errs := make([]error, added+removed)
+
+	var wg sync.WaitGroup
+	for i := 0; i < added+removed; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assignment := &pagerduty.TagAssignment{
+				Type:       "tag_reference",
+				TagID:      fmt.Sprintf("PTAG%d", i),
+				EntityType: "teams",
+				EntityID:   "PTEAM",
+			}
+			if i < added {
+				errs[i] = b.add(client, assignment)
+			} else {
+				errs[i] = b.remove(client, assignment)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func TestTagAssignmentBatches(t *testing.T) {
+	b, client, requests := testTagAssignmentBatches(t, http.StatusOK)
+
+	for i, err := range changeTagsConcurrently(b, client, 3, 2) {
+		if err != nil {
+			t.Errorf("expected change %d to succeed, got %s", i, err)
+		}
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("expected the changes to be sent in 1 request, got %d", len(*requests))
+	}
+	if added, removed := len((*requests)[0].Add), len((*requests)[0].Remove); added != 3 || removed != 2 {
+		t.Errorf("expected 3 tags added and 2 removed, got %d added and %d removed", added, removed)
+	}
+	if len(b.pending) != 0 {
+		t.Errorf("expected no pending batch once sent, got %d", len(b.pending))
+	}
+}
+
+func TestTagAssignmentBatches_Error(t *testing.T) {
+	b, client, requests := testTagAssignmentBatches(t, http.StatusForbidden)
+
+	for i, err := range changeTagsConcurrently(b, client, 2, 2) {
+		if err == nil || !isErrCode(err, http.StatusForbidden) {
+			t.Errorf("expected change %d to get the error of the batch, got %v", i, err)
+		}
+	}
+
+	if len(*requests) != 1 {
+		t.Errorf("expected the changes to be sent in 1 request, got %d", len(*requests))
+	}
+}
@@ -0,0 +1,71 @@
+package pagerduty
+
+import (
+	"sync"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// teamMembers caches the members of each team during a run, so that
+// refreshing the pagerduty_team_membership resources of a team, and the team
+// roles of its users, lists its members once instead of once per membership
+// or user. Concurrent reads of the same team wait for a single listing.
+type teamMembers struct {
+	mu    sync.Mutex
+	teams map[string]*teamMembersListing
+}
+
+type teamMembersListing struct {
+	done    chan struct{}
+	members []*pagerduty.Member
+	err     error
+}
+
+// list returns the members of the team, listing them unless they were
+// already listed during the run. Failed listings aren't cached, so that they
+// can be retried.
+func (c *teamMembers) list(client *pagerduty.Client, teamID string) ([]*pagerduty.Member, error) {
+	c.mu.Lock()
+	if l, ok := c.teams[teamID]; ok {
+		c.mu.Unlock()
+		<-l.done
+		return l.members, l.err
+	}
+
+	l := &teamMembersListing{done: make(chan struct{})}
+	if c.teams == nil {
+		c.teams = make(map[string]*teamMembersListing)
+	}
+	c.teams[teamID] = l
+	c.mu.Unlock()
+
+	// GetMembers follows pagination until every member has been read.
+	resp, _, err := client.Teams.GetMembers(teamID, &pagerduty.GetMembersOptions{})
+	if err != nil {
+		l.err = err
+		c.forget(teamID, l)
+	} else {
+		l.members = resp.Members
+	}
+	close(l.done)
+
+	return l.members, l.err
+}
+
+// invalidate drops the cached members of the team, after its memberships
+// were changed.
+func (c *teamMembers) invalidate(teamID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.teams, teamID)
+}
+
+func (c *teamMembers) forget(teamID string, l *teamMembersListing) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.teams[teamID] == l {
+		delete(c.teams, teamID)
+	}
+}
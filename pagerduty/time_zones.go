@@ -0,0 +1,150 @@
+package pagerduty
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	// time.LoadLocation reads the time zone database of the system running
+	// Terraform first, and only falls back to this embedded copy when the
+	// system has none, e.g. on Windows. Time zones newer than the system's
+	// database are rejected then, while the suggestions always come from
+	// time_zones.txt, which is tested against the embedded copy.
+	_ "time/tzdata"
+)
+
+// timeZoneList holds the names of the zones of the time zone database
+// embedded by the Go toolchain, one per line, including their older aliases
+// such as US/Eastern, except Factory.
+//
+//go:embed time_zones.txt
+var timeZoneList string
+
+var timeZoneNames = strings.Fields(timeZoneList)
+
+// timeZoneLinkList holds the Link entries of the time zone database, one per
+// line, with the alias followed by the name of the zone it links to.
+//
+//go:embed time_zone_links.txt
+var timeZoneLinkList string
+
+var timeZoneLinks = parseTimeZoneLinks(timeZoneLinkList)
+
+func parseTimeZoneLinks(list string) map[string]string {
+	links := make(map[string]string)
+	for _, line := range strings.Split(list, "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 {
+			links[fields[0]] = fields[1]
+		}
+	}
+	return links
+}
+
+// canonicalTimeZone returns the name of the zone the name links to, or the
+// name itself when it isn't an alias.
+func canonicalTimeZone(name string) string {
+	if zone, ok := timeZoneLinks[name]; ok {
+		return zone
+	}
+	return name
+}
+
+// validateTimeZone checks that the value names a zone of the IANA time zone
+// database, suggesting the closest names when it doesn't.
+func validateTimeZone(v interface{}, k string) (we []string, errors []error) {
+	value := v.(string)
+	if value != "Local" && value != "" {
+		if _, err := time.LoadLocation(value); err == nil {
+			return
+		}
+	}
+
+	msg := fmt.Sprintf("%s: %q is not a time zone of the IANA time zone database, such as America/New_York or Europe/Berlin", k, value)
+	if suggestions := suggestTimeZones(value); len(suggestions) > 0 {
+		msg += fmt.Sprintf(". Did you mean %s?", strings.Join(suggestions, " or "))
+	}
+	errors = append(errors, fmt.Errorf("%s", msg))
+	return
+}
+
+// suggestTimeZones returns up to 3 names of time zones close to the value.
+// Names differing only in case or in using spaces instead of underscores are
+// returned alone, otherwise the names with the smallest edit distance to the
+// value, or to its city when it has no region, are returned.
+func suggestTimeZones(value string) []string {
+	norm := strings.ToLower(strings.Replace(strings.TrimSpace(value), " ", "_", -1))
+	if norm == "" {
+		return nil
+	}
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for _, name := range timeZoneNames {
+		lower := strings.ToLower(name)
+		if lower == norm {
+			return []string{name}
+		}
+
+		d := levenshtein(norm, lower)
+		if !strings.Contains(norm, "/") {
+			if i := strings.LastIndex(lower, "/"); i >= 0 {
+				if cd := levenshtein(norm, lower[i+1:]); cd < d {
+					d = cd
+				}
+			}
+		}
+
+		// Allow roughly one typo every four characters
+		if d <= len(norm)/4+1 {
+			candidates = append(candidates, candidate{name, d})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	var res []string
+	for i := 0; i < len(candidates) && i < 3 && candidates[i].distance == candidates[0].distance; i++ {
+		res = append(res, candidates[i].name)
+	}
+	return res
+}
+
+// levenshtein returns the number of single character insertions, deletions
+// and substitutions needed to turn a into b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,121 @@
+package pagerduty
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateTimeZone(t *testing.T) {
+	for _, v := range []string{"America/New_York", "Europe/Berlin", "UTC", "US/Eastern", "Asia/Kolkata"} {
+		if _, errs := validateTimeZone(v, "time_zone"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %s", v, errs)
+		}
+	}
+
+	for _, v := range []string{"Local", "America/New_Yrok", "Eastern", "Mars/Olympus_Mons"} {
+		if _, errs := validateTimeZone(v, "time_zone"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+
+	_, errs := validateTimeZone("america/new york", "time_zone")
+	if len(errs) != 1 || !strings.HasSuffix(errs[0].Error(), "Did you mean America/New_York?") {
+		t.Errorf("expected America/New_York to be suggested, got %v", errs)
+	}
+}
+
+func TestSuggestTimeZones(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected []string
+	}{
+		{"europe/berlin", []string{"Europe/Berlin"}},
+		{"Europe/Berln", []string{"Europe/Berlin"}},
+		{"Kolkata", []string{"Asia/Kolkata"}},
+		{"Mars/Olympus_Mons", nil},
+	}
+
+	for _, c := range cases {
+		if got := suggestTimeZones(c.value); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.value, c.expected, got)
+		}
+	}
+}
+
+func TestTimeZoneLinks(t *testing.T) {
+	if len(timeZoneLinks) == 0 {
+		t.Fatal("expected the Link entries of the time zone database to be embedded")
+	}
+
+	for alias, zone := range timeZoneLinks {
+		if _, ok := timeZoneLinks[zone]; ok {
+			t.Errorf("expected %s to link to a zone, but %s is an alias too", alias, zone)
+		}
+		for _, name := range []string{alias, zone} {
+			if _, err := time.LoadLocation(name); err != nil {
+				t.Errorf("expected %s to be in the embedded time zone database: %s", name, err)
+			}
+		}
+	}
+}
+
+// TestTimeZoneNames checks time_zones.txt against the time zone database
+// embedded by the Go toolchain, so that it's regenerated when the database
+// gains or drops zones.
+func TestTimeZoneNames(t *testing.T) {
+	goroot, err := exec.Command("go", "env", "GOROOT").Output()
+	if err != nil {
+		t.Skipf("unable to find the Go toolchain: %s", err)
+	}
+
+	path := filepath.Join(strings.TrimSpace(string(goroot)), "lib", "time", "zoneinfo.zip")
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		t.Skipf("unable to open the time zone database of the Go toolchain: %s", err)
+	}
+	defer r.Close()
+
+	listed := make(map[string]bool)
+	for _, name := range timeZoneNames {
+		listed[name] = true
+	}
+
+	for _, f := range r.File {
+		if f.Name == "Factory" {
+			continue
+		}
+		if !listed[f.Name] {
+			t.Errorf("expected %s of %s to be in time_zones.txt", f.Name, path)
+		}
+		delete(listed, f.Name)
+
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := time.LoadLocationFromTZData(f.Name, data); err != nil {
+			t.Errorf("expected %s to be a valid time zone: %s", f.Name, err)
+		}
+	}
+
+	for name := range listed {
+		t.Errorf("expected %s of time_zones.txt to be in %s", name, path)
+	}
+
+	for _, name := range timeZoneNames {
+		if _, err := time.LoadLocation(name); err != nil {
+			t.Errorf("expected %s to be loaded: %s", name, err)
+		}
+	}
+}
@@ -1,17 +1,217 @@
 package pagerduty
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"html"
 	"log"
 	"math"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
+var pagerDutyIDRegexp = regexp.MustCompile(`^[A-Z0-9]{7,}$`)
+
+// looksLikePagerDutyID reports whether v has the shape of a PagerDuty object
+// ID, e.g. PLBP09X, as opposed to a human-friendly name.
+func looksLikePagerDutyID(v string) bool {
+	return pagerDutyIDRegexp.MatchString(v)
+}
+
+// validateReferenceID validates that the id of a reference to another object
+// has the shape of a PagerDuty ID.
+func validateReferenceID(v interface{}, k string) (we []string, errors []error) {
+	if value := v.(string); value != "" && !looksLikePagerDutyID(value) {
+		errors = append(errors, fmt.Errorf("%q is not a valid PagerDuty ID for argument %s", value, k))
+	}
+
+	return
+}
+
+// parseCompositeImportID splits the import ID of a nested resource into the
+// given parts. Parts are separated by colons, e.g. `<parent_id>:<child_id>`.
+// IDs separated by dots are accepted too, as older versions of the provider
+// documented them for some resources.
+func parseCompositeImportID(resourceType, id string, parts ...string) ([]string, error) {
+	return splitCompositeImportID(resourceType, id, -1, parts)
+}
+
+// parseCompositeImportIDWithTail is like parseCompositeImportID, except that
+// the last part may contain the separator, e.g. the email of
+// `<response_play_id>:<from_email>`.
+func parseCompositeImportIDWithTail(resourceType, id string, parts ...string) ([]string, error) {
+	return splitCompositeImportID(resourceType, id, len(parts), parts)
+}
+
+func splitCompositeImportID(resourceType, id string, n int, parts []string) ([]string, error) {
+	sep := ":"
+	if !strings.Contains(id, sep) {
+		sep = "."
+	}
+
+	ids := strings.SplitN(id, sep, n)
+
+	valid := len(ids) == len(parts)
+	for _, v := range ids {
+		if v == "" {
+			valid = false
+		}
+	}
+
+	if !valid {
+		format := make([]string, len(parts))
+		for i, p := range parts {
+			format[i] = fmt.Sprintf("<%s>", p)
+		}
+		return nil, fmt.Errorf("Error importing %s. Expecting an importation ID formed as '%s', got: %q", resourceType, strings.Join(format, ":"), id)
+	}
+
+	return ids, nil
+}
+
+// importByNamePrefix prefixes the import IDs naming the object to import
+// instead of giving its ID, e.g. `name:Platform`.
+const importByNamePrefix = "name:"
+
+// namedObject is an object listed to look up the one to import by name.
+type namedObject struct {
+	ID   string
+	Name string
+}
+
+// importStateByName returns an importer accepting either the ID of the object
+// to import or its name prefixed with "name:". list lists the objects whose
+// name matches query.
+func importStateByName(resourceType, kind string, list func(client *pagerduty.Client, query string) ([]namedObject, error)) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if !strings.HasPrefix(d.Id(), importByNamePrefix) {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		return importByName(d, meta, resourceType, kind, strings.TrimPrefix(d.Id(), importByNamePrefix), list)
+	}
+}
+
+// importByName sets the ID of d to the ID of the only object named name. It
+// fails rather than guess when several objects have that name.
+func importByName(d *schema.ResourceData, meta interface{}, resourceType, kind, name string, list func(client *pagerduty.Client, query string) ([]namedObject, error)) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	found, err := findObjectsByName(client, name, list)
+	if err != nil {
+		return []*schema.ResourceData{}, fmt.Errorf("error importing %s: %s", resourceType, err)
+	}
+
+	switch len(found) {
+	case 0:
+		return []*schema.ResourceData{}, fmt.Errorf("error importing %s. No %s found with the name: %s", resourceType, kind, name)
+	case 1:
+		d.SetId(found[0])
+		return []*schema.ResourceData{d}, nil
+	default:
+		return []*schema.ResourceData{}, fmt.Errorf("error importing %s. The name %q matches %d objects (%s), import using the ID instead", resourceType, name, len(found), strings.Join(found, ", "))
+	}
+}
+
+// findExistingByName returns the ID of the existing object named name, or an
+// empty string if there's none, for resources adopting existing objects on
+// create.
+func findExistingByName(client *pagerduty.Client, kind, name string, list func(client *pagerduty.Client, query string) ([]namedObject, error)) (string, error) {
+	found, err := findObjectsByName(client, name, list)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(found) {
+	case 0:
+		return "", nil
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("Unable to adopt the existing %s named %q, the name matches %d objects (%s)", kind, name, len(found), strings.Join(found, ", "))
+	}
+}
+
+// checkAdoptedAttribute refuses to adopt an existing object whose key
+// attribute differs from the configuration, as the object found by name then
+// likely belongs to something else, which adopting it would take over.
+func checkAdoptedAttribute(kind, name, id, attr, existing, configured string) error {
+	if existing == configured {
+		return nil
+	}
+	return fmt.Errorf("Unable to adopt the existing %s named %q (%s), as %s doesn't match: %q in PagerDuty, %q in the configuration. "+
+		"Rename the %s in the configuration, or make its %s match to adopt it", kind, name, id, attr, existing, configured, kind, attr)
+}
+
+func findObjectsByName(client *pagerduty.Client, name string, list func(client *pagerduty.Client, query string) ([]namedObject, error)) ([]string, error) {
+	objects, err := list(client, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []string
+	for _, o := range objects {
+		if o.Name == name {
+			found = append(found, o.ID)
+		}
+	}
+
+	return found, nil
+}
+
+// checkDeletionProtection refuses to delete a resource while deletion_protection
+// is set in its state. The check runs against the state, so removing the
+// resource from the configuration isn't enough to delete it.
+func checkDeletionProtection(d *schema.ResourceData, resourceType string) error {
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Error deleting %s %s: deletion_protection is enabled, set it to false and apply before destroying it", resourceType, d.Id())
+	}
+	return nil
+}
+
+// clearDescription sends an explicit empty description when it was emptied in
+// the configuration. The client leaves empty descriptions out of the payload,
+// so the previous description would otherwise be kept and show up as a diff
+// on every plan. key is the name of the object in the payload, e.g. team.
+func clearDescription(client *pagerduty.Client, d *schema.ResourceData, path, key string) error {
+	if !d.HasChange("description") || d.Get("description").(string) != "" {
+		return nil
+	}
+
+	log.Printf("[INFO] Clearing the description of %s", path)
+
+	body := map[string]interface{}{
+		key: map[string]interface{}{
+			"description": "",
+		},
+	}
+	_, err := apiRequest(client, "PUT", path, nil, body, nil)
+	return err
+}
+
+// errNotFound is returned by data sources when nothing matches their search,
+// and names every search term used, e.g. errNotFound("team", "name", name).
+func errNotFound(kind string, terms ...string) error {
+	var parts []string
+	for i := 0; i+1 < len(terms); i += 2 {
+		if terms[i+1] != "" {
+			parts = append(parts, fmt.Sprintf("%s: %s", terms[i], terms[i+1]))
+		}
+	}
+	return fmt.Errorf("Unable to locate any %s with the %s", kind, strings.Join(parts, ", the "))
+}
+
 func timeToUTC(v string) (time.Time, error) {
 	t, err := time.Parse(time.RFC3339, v)
 	if err != nil {
@@ -74,12 +274,134 @@ func parseRFC3339Time(k, oldTime, newTime string) (time.Time, time.Time, error)
 	return oldT, newT, nil
 }
 
+var timeOfDayRegexp = regexp.MustCompile(`^([0-1][0-9]|2[0-3]):[0-5][0-9](:[0-5][0-9])?$`)
+
+// validateTimeOfDay accepts a time of day as HH:MM or HH:MM:SS.
+func validateTimeOfDay(v interface{}, k string) (we []string, errors []error) {
+	value := v.(string)
+	if !timeOfDayRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a time of day in the HH:MM:SS or HH:MM format, got: %s", k, value))
+	}
+	return
+}
+
+// normalizeTimeOfDay adds the seconds to a time of day given as HH:MM, as the
+// API always returns them.
+func normalizeTimeOfDay(v string) string {
+	if len(v) == len("15:04") && timeOfDayRegexp.MatchString(v) {
+		return v + ":00"
+	}
+	return v
+}
+
+func suppressTimeOfDayDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeTimeOfDay(old) == normalizeTimeOfDay(new)
+}
+
+// suppressTimeZoneDiff hides the diff between names of the same time zone,
+// e.g. UTC and Etc/UTC or US/Eastern and America/New_York, which are aliases
+// linked to the same zone by the time zone database. Distinct zones which
+// happen to follow the same rules today, such as Europe/Berlin and
+// Europe/Paris, still differ.
+func suppressTimeZoneDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+	return canonicalTimeZone(old) == canonicalTimeZone(new)
+}
+
+// namePattern returns search as a case-insensitive regular expression, or
+// one matching names containing it if it isn't a valid expression.
+func namePattern(search string) *regexp.Regexp {
+	pattern, err := regexp.Compile("(?i)" + search)
+	if err != nil {
+		pattern = regexp.MustCompile("(?i)" + regexp.QuoteMeta(search))
+	}
+	return pattern
+}
+
+// bestNameMatch returns the index of the name best matching search, or -1 if
+// none of them matches. Names equal to search, ignoring case, are preferred.
+// Unless exactMatch is set, names matching search as a case-insensitive
+// regular expression, or containing it if it isn't one, match too. Ties are
+// broken by choosing the shortest name, then the lexically smallest one, so
+// that the result doesn't depend on the order the API returns names in.
+func bestNameMatch(search string, names []string, exactMatch bool) int {
+	best := -1
+	bestExact := false
+
+	partial := namePattern(search)
+
+	for i, name := range names {
+		exact := strings.EqualFold(name, search)
+		if !exact && (exactMatch || !partial.MatchString(name)) {
+			continue
+		}
+
+		switch {
+		case best == -1:
+		case exact != bestExact:
+			if !exact {
+				continue
+			}
+		case len(name) != len(names[best]):
+			if len(name) > len(names[best]) {
+				continue
+			}
+		case name >= names[best]:
+			continue
+		}
+
+		best, bestExact = i, exact
+	}
+
+	return best
+}
+
+const sensitiveValueHashPrefix = "sha256:"
+
+// hashSensitiveValue is a StateFunc for write-only secrets: the state only
+// keeps a hash of the configured value, which is enough to detect changes
+// without storing the secret itself.
+func hashSensitiveValue(v interface{}) string {
+	value := v.(string)
+	if value == "" || isHashedSensitiveValue(value) {
+		return value
+	}
+
+	sum := sha256.Sum256([]byte(value))
+	return sensitiveValueHashPrefix + hex.EncodeToString(sum[:])
+}
+
+func isHashedSensitiveValue(v string) bool {
+	return strings.HasPrefix(v, sensitiveValueHashPrefix)
+}
+
 func suppressLeadTrailSpaceDiff(k, old, new string, d *schema.ResourceData) bool {
 	return old == strings.TrimSpace(new)
 }
 
 func suppressCaseDiff(k, old, new string, d *schema.ResourceData) bool {
-	return old == strings.ToLower(new)
+	return strings.EqualFold(old, new)
+}
+
+// suppressHTMLEntityDiff hides the diff between names and descriptions which
+// only differ by HTML entities, as PagerDuty returns some characters escaped,
+// e.g. "R&amp;D" for "R&D".
+func suppressHTMLEntityDiff(k, old, new string, d *schema.ResourceData) bool {
+	return html.UnescapeString(old) == html.UnescapeString(new)
+}
+
+// normalizeJSONString stores JSON documents with sorted keys and without
+// whitespace, so that the state matches what's read back from the API however
+// the document was formatted in the configuration.
+func normalizeJSONString(v interface{}) string {
+	json, err := structure.NormalizeJsonString(v)
+	if err != nil {
+		// Invalid documents are rejected by the validation of the attribute
+		return v.(string)
+	}
+	return json
 }
 
 // Validate a value against a set of possible values
@@ -95,12 +417,41 @@ func validateValueFunc(values []string) schema.SchemaValidateFunc {
 		}
 
 		if !valid {
-			errors = append(errors, fmt.Errorf("%#v is an invalid value for argument %s. Must be one of %#v", value, k, values))
+			msg := fmt.Sprintf("%#v is an invalid value for argument %s. Must be one of %#v", value, k, values)
+			if suggestion := suggestValue(value, values); suggestion != "" {
+				msg += fmt.Sprintf(". Did you mean %#v?", suggestion)
+			}
+			errors = append(errors, fmt.Errorf("%s", msg))
 		}
 		return
 	}
 }
 
+// suggestValue returns the value closest to the given one, when it differs
+// only in case, separators or a couple of typos, and no other value is as
+// close.
+func suggestValue(value string, values []string) string {
+	norm := strings.ToLower(strings.NewReplacer("-", "_", " ", "_").Replace(strings.TrimSpace(value)))
+	if norm == "" {
+		return ""
+	}
+
+	best, bestDistance, ties := "", 3, 0
+	for _, val := range values {
+		d := levenshtein(norm, strings.ToLower(strings.Replace(val, "-", "_", -1)))
+		switch {
+		case d < bestDistance:
+			best, bestDistance, ties = val, d, 0
+		case d == bestDistance:
+			ties++
+		}
+	}
+	if ties > 0 {
+		return ""
+	}
+	return best
+}
+
 // Takes the result of flatmap.Expand for an array of strings
 // and returns a []string
 func expandStringList(configured []interface{}) []string {
@@ -155,6 +506,16 @@ func intTypeToIntPtr(v int) *int {
 	return &v
 }
 
+// createOrUpdateTimeout returns the create timeout for resources whose Create
+// is done by their Update, which is called before the resource has an ID or
+// right after it's created, and the update timeout otherwise.
+func createOrUpdateTimeout(d *schema.ResourceData) time.Duration {
+	if d.Id() == "" || d.IsNewResource() {
+		return d.Timeout(schema.TimeoutCreate)
+	}
+	return d.Timeout(schema.TimeoutUpdate)
+}
+
 // renderRoundedPercentage is a helper function to render percentanges
 // represented as float64 numbers, by its round with two decimals string
 // representation.
@@ -174,3 +535,27 @@ func isNilFunc(i interface{}) bool {
 	}
 	return false
 }
+
+// computedSchema returns a copy of a resource attribute in which it and its
+// nested attributes are only computed, so that data sources can export
+// objects with the same shape as the resource managing them.
+func computedSchema(s *schema.Schema) *schema.Schema {
+	c := &schema.Schema{
+		Type:        s.Type,
+		Description: s.Description,
+		Computed:    true,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		nested := make(map[string]*schema.Schema, len(elem.Schema))
+		for k, v := range elem.Schema {
+			nested[k] = computedSchema(v)
+		}
+		c.Elem = &schema.Resource{Schema: nested}
+	case *schema.Schema:
+		c.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return c
+}
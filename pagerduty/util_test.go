@@ -0,0 +1,239 @@
+package pagerduty
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseCompositeImportID(t *testing.T) {
+	cases := []struct {
+		id       string
+		parts    []string
+		tail     bool
+		expected []string
+		err      string
+	}{
+		{id: "PSERVICE:PINTEGRATION", parts: []string{"service_id", "integration_id"}, expected: []string{"PSERVICE", "PINTEGRATION"}},
+		{id: "PSERVICE.PINTEGRATION", parts: []string{"service_id", "integration_id"}, expected: []string{"PSERVICE", "PINTEGRATION"}},
+		{id: "users:PUSER:PTAG", parts: []string{"entity_type", "entity_id", "tag_id"}, expected: []string{"users", "PUSER", "PTAG"}},
+		{id: "PSERVICE", parts: []string{"service_id", "integration_id"}, err: "'<service_id>:<integration_id>'"},
+		{id: "PSERVICE:", parts: []string{"service_id", "integration_id"}, err: "'<service_id>:<integration_id>'"},
+		{id: "PSERVICE:PINTEGRATION:PEXTRA", parts: []string{"service_id", "integration_id"}, err: "'<service_id>:<integration_id>'"},
+		{id: "PPLAY:user@foo.test", parts: []string{"response_play_id", "from_email"}, tail: true, expected: []string{"PPLAY", "user@foo.test"}},
+		{id: "PPLAY.user@foo.test", parts: []string{"response_play_id", "from_email"}, tail: true, expected: []string{"PPLAY", "user@foo.test"}},
+		{id: "PPLAY.user@foo.test", parts: []string{"response_play_id", "from_email"}, err: "'<response_play_id>:<from_email>'"},
+	}
+
+	for _, c := range cases {
+		parse := parseCompositeImportID
+		if c.tail {
+			parse = parseCompositeImportIDWithTail
+		}
+
+		ids, err := parse("pagerduty_foo", c.id, c.parts...)
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%q: expected an error containing %s, got: %v", c.id, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", c.id, err)
+			continue
+		}
+		if !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("%q: expected %v, got %v", c.id, c.expected, ids)
+		}
+	}
+}
+
+func TestCheckDeletionProtection(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+
+	d := r.TestResourceData()
+	d.SetId("PFOOBAR")
+	if err := checkDeletionProtection(d, "pagerduty_service"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d.Set("deletion_protection", true)
+	err := checkDeletionProtection(d, "pagerduty_service")
+	if err == nil || !strings.Contains(err.Error(), "pagerduty_service PFOOBAR") {
+		t.Fatalf("expected a deletion protection error, got %v", err)
+	}
+}
+
+func TestNormalizeJSONString(t *testing.T) {
+	cases := map[string]string{
+		`{"b": 1,  "a": {"d": [1, 2], "c": null}}`: `{"a":{"c":null,"d":[1,2]},"b":1}`,
+		`[ {"type": "route"} ]`:                    `[{"type":"route"}]`,
+		`not json`:                                 `not json`,
+	}
+
+	for in, expected := range cases {
+		if got := normalizeJSONString(in); got != expected {
+			t.Errorf("expected %s to be normalized to %s, got %s", in, expected, got)
+		}
+	}
+}
+
+func TestSuppressTimeZoneDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		expected bool
+	}{
+		{"America/New_York", "America/New_York", true},
+		{"UTC", "Etc/UTC", true},
+		{"US/Eastern", "America/New_York", true},
+		{"UCT", "Zulu", true},
+		{"Europe/London", "Europe/Lisbon", false},
+		{"Europe/Berlin", "Europe/Paris", false},
+		{"America/New_York", "America/Chicago", false},
+		{"", "UTC", false},
+		{"Not/AZone", "UTC", false},
+	}
+
+	for _, c := range cases {
+		if got := suppressTimeZoneDiff("time_zone", c.old, c.new, nil); got != c.expected {
+			t.Errorf("expected the diff between %q and %q to be suppressed: %t, got %t", c.old, c.new, c.expected, got)
+		}
+	}
+}
+
+func TestSuppressCaseDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		expected bool
+	}{
+		{"jane@example.com", "jane@example.com", true},
+		{"jane@example.com", "Jane@Example.com", true},
+		{"Jane@Example.com", "jane@example.com", true},
+		{"jane@example.com", "john@example.com", false},
+	}
+
+	for _, c := range cases {
+		if got := suppressCaseDiff("email", c.old, c.new, nil); got != c.expected {
+			t.Errorf("expected the diff between %q and %q to be suppressed: %t, got %t", c.old, c.new, c.expected, got)
+		}
+	}
+}
+
+func TestSuppressHTMLEntityDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		expected bool
+	}{
+		{"R&D", "R&D", true},
+		{"R&amp;D", "R&D", true},
+		{"Jane&#39;s &quot;team&quot;", `Jane's "team"`, true},
+		{"&lt;b&gt;", "<b>", true},
+		{"R&amp;D", "R and D", false},
+		{"R&D", "RD", false},
+	}
+
+	for _, c := range cases {
+		if got := suppressHTMLEntityDiff("description", c.old, c.new, nil); got != c.expected {
+			t.Errorf("expected the diff between %q and %q to be suppressed: %t, got %t", c.old, c.new, c.expected, got)
+		}
+	}
+}
+
+func TestSuppressTimeOfDayDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		expected bool
+	}{
+		{"09:00:00", "09:00", true},
+		{"09:00:00", "09:00:00", true},
+		{"09:00:00", "09:00:30", false},
+		{"17:30:00", "17:00", false},
+	}
+
+	for _, c := range cases {
+		if got := suppressTimeOfDayDiff("start_time", c.old, c.new, nil); got != c.expected {
+			t.Errorf("expected the diff between %q and %q to be suppressed: %t, got %t", c.old, c.new, c.expected, got)
+		}
+	}
+
+	for _, v := range []string{"24:00", "9:00", "09:60:00", "09:00:00Z"} {
+		if _, errs := validateTimeOfDay(v, "start_time"); len(errs) == 0 {
+			t.Errorf("expected %q to be an invalid time of day", v)
+		}
+	}
+}
+
+func TestErrNotFound(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected string
+	}{
+		{errNotFound("vendor", "name", "cloudwatch"), "Unable to locate any vendor with the name: cloudwatch"},
+		{errNotFound("contact method", "user_id", "PUSER", "type", "email_contact_method", "label", "", "address", "foo@bar.test"),
+			"Unable to locate any contact method with the user_id: PUSER, the type: email_contact_method, the address: foo@bar.test"},
+	}
+
+	for _, c := range cases {
+		if c.err.Error() != c.expected {
+			t.Errorf("expected %q, got %q", c.expected, c.err.Error())
+		}
+	}
+}
+
+func TestValidateValueFuncSuggestion(t *testing.T) {
+	cases := []struct {
+		value   string
+		message string
+	}{
+		{"Admin", `"Admin" is an invalid value for argument role. Must be one of []string{"admin", "limited_user", "observer", "owner", "read_only_user", "restricted_access", "read_only_limited_user", "user"}. Did you mean "admin"?`},
+		{"read-only-user", `. Did you mean "read_only_user"?`},
+		{"limted_user", `. Did you mean "limited_user"?`},
+		{"superuser", `. Must be one of`},
+	}
+
+	for _, c := range cases {
+		_, errs := validateUserRole()(c.value, "role")
+		if len(errs) != 1 {
+			t.Fatalf("expected 1 error for %q, got %v", c.value, errs)
+		}
+		if !strings.Contains(errs[0].Error(), c.message) {
+			t.Errorf("expected the error for %q to contain %q, got %q", c.value, c.message, errs[0])
+		}
+	}
+
+	if _, errs := validateUserRole()("superuser", "role"); strings.Contains(errs[0].Error(), "Did you mean") {
+		t.Errorf("expected no suggestion for %q, got %q", "superuser", errs[0])
+	}
+}
+
+func TestSuggestValue(t *testing.T) {
+	colors := []string{"red", "green", "dark-green", "blue"}
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"Red", "red"},
+		{"dark_green", "dark-green"},
+		{"dark green", "dark-green"},
+		{"gren", "green"},
+		{"rod", "red"},
+		{"yellow", ""},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := suggestValue(c.value, colors); got != c.expected {
+			t.Errorf("expected the suggestion for %q to be %q, got %q", c.value, c.expected, got)
+		}
+	}
+}
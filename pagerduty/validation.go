@@ -0,0 +1,151 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The values below are shared by attributes of several resources, so that
+// they are checked the same way everywhere and invalid values fail at plan
+// rather than at apply.
+
+// validateUrgency validates the urgency of notification rules.
+func validateUrgency() schema.SchemaValidateFunc {
+	return validateValueFunc([]string{
+		"high",
+		"low",
+	})
+}
+
+// validateIncidentUrgency validates the urgency given to the incidents of a
+// service, which can also follow the severity of the alerts.
+func validateIncidentUrgency() schema.SchemaValidateFunc {
+	return validateValueFunc([]string{
+		"high",
+		"low",
+		"severity_based",
+	})
+}
+
+// validateSeverity validates the severity of alerts set by event rules and
+// Event Orchestrations.
+func validateSeverity() schema.SchemaValidateFunc {
+	return validateValueFunc([]string{
+		"info",
+		"error",
+		"warning",
+		"critical",
+	})
+}
+
+// userRoles are the base roles of users. The owner role can't be given
+// through the API, see checkUserRoleAssignable.
+var userRoles = []string{
+	"admin",
+	"limited_user",
+	"observer",
+	"owner",
+	"read_only_user",
+	"restricted_access",
+	"read_only_limited_user",
+	"user",
+}
+
+// validateUserRole validates the base role of users.
+func validateUserRole() schema.SchemaValidateFunc {
+	return validateValueFunc(userRoles)
+}
+
+// validateTeamRole validates the role of users in a team.
+func validateTeamRole() schema.SchemaValidateFunc {
+	return validateValueFunc([]string{
+		"observer",
+		"responder",
+		"manager",
+	})
+}
+
+// userColors are the colors PagerDuty shows users with in schedules.
+var userColors = []string{
+	"purple",
+	"red",
+	"green",
+	"blue",
+	"teal",
+	"orange",
+	"brown",
+	"turquoise",
+	"dark-slate-blue",
+	"cayenne",
+	"orange-red",
+	"dark-orchid",
+	"dark-slate-grey",
+	"lime",
+	"dark-magenta",
+	"lime-green",
+	"midnight-blue",
+	"deep-pink",
+	"dark-green",
+	"dark-orange",
+	"dark-cyan",
+	"darkolive-green",
+	"dark-slate-gray",
+	"grey20",
+	"firebrick",
+	"maroon",
+	"crimson",
+	"dark-red",
+	"dark-goldenrod",
+	"chocolate",
+	"medium-violet-red",
+	"sea-green",
+	"olivedrab",
+	"forest-green",
+	"dark-olive-green",
+	"blue-violet",
+	"royal-blue",
+	"indigo",
+	"slate-blue",
+	"saddle-brown",
+	"steel-blue",
+}
+
+// validateUserColor validates the schedule color of users.
+func validateUserColor() schema.SchemaValidateFunc {
+	return validateValueFunc(userColors)
+}
+
+// validateEmail validates email addresses, which must be a bare address with
+// a domain, e.g. jane@example.com rather than "Jane <jane@example.com>".
+func validateEmail(v interface{}, k string) (we []string, errors []error) {
+	value := v.(string)
+
+	addr, err := mail.ParseAddress(value)
+	if err != nil || addr.Address != value || addr.Name != "" {
+		errors = append(errors, fmt.Errorf("%s: %q is not a valid email address", k, value))
+		return
+	}
+	if domain := value[strings.LastIndex(value, "@")+1:]; !strings.Contains(domain, ".") {
+		errors = append(errors, fmt.Errorf("%s: %q is not a valid email address, its domain %q has no top level domain", k, value, domain))
+	}
+	return
+}
+
+// validateRegex validates regular expressions evaluated by PagerDuty, which
+// uses the RE2 syntax of Go's regexp package. The error names the attribute
+// and the problem, as the API only reports that the request is invalid.
+func validateRegex(v interface{}, k string) (we []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	if _, err := regexp.Compile(value); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %q is not a valid RE2 regular expression: %v", k, value, err))
+	}
+	return
+}
@@ -0,0 +1,51 @@
+package pagerduty
+
+import "testing"
+
+func TestValidateEmail(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{"jane@example.com", true},
+		{"Jane.Doe+pd@Mail.Example.co.uk", true},
+		{"", false},
+		{"jane", false},
+		{"jane@", false},
+		{"@example.com", false},
+		{"jane@localhost", false},
+		{"jane@@example.com", false},
+		{"Jane <jane@example.com>", false},
+		{" jane@example.com", false},
+	}
+
+	for _, c := range cases {
+		_, errs := validateEmail(c.value, "email")
+		if valid := len(errs) == 0; valid != c.valid {
+			t.Errorf("expected %q to be valid: %t, got errors: %v", c.value, c.valid, errs)
+		}
+	}
+}
+
+func TestValidateRegex(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{"", true},
+		{"host-(\\d+)", true},
+		{"(?i)^error: (?P<code>[A-Z]+)$", true},
+		{"host-(\\d+", false},
+		{"[a-z", false},
+		// Lookarounds and backreferences aren't supported by RE2
+		{"foo(?=bar)", false},
+		{"(a)\\1", false},
+	}
+
+	for _, c := range cases {
+		_, errs := validateRegex(c.value, "regex")
+		if valid := len(errs) == 0; valid != c.valid {
+			t.Errorf("expected %q to be valid: %t, got errors: %v", c.value, c.valid, errs)
+		}
+	}
+}